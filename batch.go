@@ -0,0 +1,83 @@
+package i18n
+
+import (
+	"context"
+	"strings"
+)
+
+// GetMany returns the translated messages for the message ids, keyed by message ID.
+//
+// The language is resolved from the context once for all messages, and the options apply to every message.
+//
+// Example:
+//
+//	labels := i18n.GetMany(ctx, []string{"settings.title", "settings.save"})
+func GetMany(ctx context.Context, ids []string, opts ...any) map[string]string {
+	messages := make(map[string]string, len(ids))
+	if bundle == nil {
+		for _, id := range ids {
+			messages[id] = "ERROR: i18n is not initialized"
+		}
+		return messages
+	}
+	lang := extractLanguageFunc(ctx)
+	for _, id := range ids {
+		messages[id] = localize(ctx, lang, id, opts...)
+	}
+	return messages
+}
+
+// GetAllWithPrefix returns the translated messages of all message IDs starting with prefix, keyed by message ID.
+//
+// Messages of the default language and of the language of the context are included, and messages missing
+// in the language of the context fall back to the default language. With the Namespace option,
+// the prefix and the returned IDs are relative to the namespace.
+//
+// Example:
+//
+//	labels := i18n.GetAllWithPrefix(ctx, "settings.") // {"settings.title": "Settings", "settings.save": "Save"}
+func GetAllWithPrefix(ctx context.Context, prefix string, opts ...any) map[string]string {
+	if bundle == nil {
+		return map[string]string{}
+	}
+	lang := extractLanguageFunc(ctx)
+	ids := messageIDsWithPrefix(newLocalizeConfig(opts...), lang, prefix)
+	messages := make(map[string]string, len(ids))
+	for _, id := range ids {
+		messages[id] = localize(ctx, lang, id, opts...)
+	}
+	return messages
+}
+
+// messageIDsWithPrefix returns the IDs of the messages starting with prefix in the default language
+// and in the language matched for the requested languages, relative to the namespace of cfg.
+func messageIDsWithPrefix(cfg *localizeConfig, lang, prefix string) []string {
+	namespaces := []string{""}
+	if cfg.namespace != "" {
+		namespaces = []string{cfg.namespace}
+		if namespaceFallback != "" && namespaceFallback != cfg.namespace {
+			namespaces = append(namespaces, namespaceFallback)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	tag := localizers.get(requestedLanguages(cfg, lang)).tag
+	overrides := currentRuntimeMessages()
+	for _, id := range append(messageIDs(overrides, defaultLanguage), messageIDs(overrides, tag)...) {
+		if isVariantID(id) {
+			continue
+		}
+		for _, namespace := range namespaces {
+			relativeID, ok := id, true
+			if namespace != "" {
+				relativeID, ok = strings.CutPrefix(id, namespace+namespaceSeparator)
+			}
+			if ok && strings.HasPrefix(relativeID, prefix) && !seen[relativeID] {
+				seen[relativeID] = true
+				ids = append(ids, relativeID)
+			}
+		}
+	}
+	return ids
+}
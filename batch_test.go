@@ -0,0 +1,123 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMany(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name     string
+		lang     string
+		ids      []string
+		opts     []any
+		expected map[string]string
+	}{
+		{
+			name: "default language",
+			ids:  []string{"settings.title", "settings.save"},
+			expected: map[string]string{
+				"settings.title": "Settings",
+				"settings.save":  "Save",
+			},
+		},
+		{
+			name: "context language with fallback",
+			lang: "id",
+			ids:  []string{"settings.title", "settings.advanced"},
+			expected: map[string]string{
+				"settings.title":    "Pengaturan",
+				"settings.advanced": "Advanced",
+			},
+		},
+		{
+			name: "options apply to every message",
+			lang: "id",
+			ids:  []string{"settings.greeting", "missing"},
+			opts: []any{i18n.Params{"name": "John"}},
+			expected: map[string]string{
+				"settings.greeting": "Halo, John",
+				"missing":           `ERROR: missing translation for "missing"`,
+			},
+		},
+		{
+			name:     "no ids",
+			expected: map[string]string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.GetMany(ctx, tc.ids, tc.opts...))
+		})
+	}
+}
+
+func TestGetAllWithPrefix(t *testing.T) {
+	initI18n(t,
+		i18n.WithNamespaceFile("billing", "testdata/billing.en.yaml", "testdata/billing.id.yaml"),
+		i18n.WithNamespaceFile("common", "testdata/common.en.yaml", "testdata/common.id.yaml"),
+		i18n.WithNamespaceFallback("common"),
+	)
+
+	testCases := []struct {
+		name     string
+		lang     string
+		prefix   string
+		opts     []any
+		expected map[string]string
+	}{
+		{
+			name:   "default language",
+			prefix: "settings.",
+			opts:   []any{i18n.Params{"name": "John"}},
+			expected: map[string]string{
+				"settings.title":    "Settings",
+				"settings.save":     "Save",
+				"settings.greeting": "Hello, John",
+				"settings.advanced": "Advanced",
+			},
+		},
+		{
+			name:   "context language includes messages of both languages",
+			lang:   "id",
+			prefix: "settings.",
+			opts:   []any{i18n.Params{"name": "John"}},
+			expected: map[string]string{
+				"settings.title":    "Pengaturan",
+				"settings.save":     "Simpan",
+				"settings.greeting": "Halo, John",
+				"settings.advanced": "Advanced",
+				"settings.language": "Bahasa",
+			},
+		},
+		{
+			name:   "namespace with fallback namespace",
+			lang:   "id",
+			prefix: "",
+			opts:   []any{i18n.Namespace("billing")},
+			expected: map[string]string{
+				"title":   "Tagihan",
+				"invoice": "Invoice #<no value>",
+				"save":    "Simpan",
+				"cancel":  "Cancel",
+			},
+		},
+		{
+			name:     "unknown prefix",
+			prefix:   "unknown.",
+			expected: map[string]string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.GetAllWithPrefix(ctx, tc.prefix, tc.opts...))
+		})
+	}
+}
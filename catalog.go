@@ -0,0 +1,195 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"sort"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// catalogMessage is a message added to the bundle along with the file it was loaded from.
+type catalogMessage struct {
+	message *i18n.Message
+	path    string
+}
+
+// catalog keeps the messages added to the bundle, since the bundle doesn't expose them.
+var catalog map[language.Tag]map[string]*catalogMessage
+
+// addMessages adds the messages loaded from path to the bundle and the catalog.
+func addMessages(tag language.Tag, path string, messages ...*i18n.Message) error {
+	if err := bundle.AddMessages(tag, messages...); err != nil {
+		return err
+	}
+	if catalog == nil {
+		catalog = make(map[language.Tag]map[string]*catalogMessage)
+	}
+	if catalog[tag] == nil {
+		catalog[tag] = make(map[string]*catalogMessage)
+	}
+	for _, message := range messages {
+		if existing, ok := catalog[tag][message.ID]; ok {
+			logAttrs(context.Background(), slog.LevelWarn, "i18n: message overridden",
+				slog.String("id", message.ID), slog.String("language", tag.String()),
+				slog.String("path", path), slog.String("previous_path", existing.path))
+		}
+		catalog[tag][message.ID] = &catalogMessage{message: message, path: path}
+	}
+	logAttrs(context.Background(), slog.LevelDebug, "i18n: loaded messages",
+		slog.String("path", path), slog.String("language", tag.String()), slog.Int("count", len(messages)))
+	return nil
+}
+
+// loadMessageFile reads a YAML, JSON or TOML message file and adds its messages to the bundle.
+// Messages of a namespaced file are prefixed with the namespace.
+func loadMessageFile(fsys fs.FS, path, namespace string, c *config) error {
+	data, err := readFile(fsys, path)
+	if err != nil {
+		return err
+	}
+	file, err := parseMessageFile(data, path, c)
+	if err != nil {
+		return err
+	}
+	if namespace != "" {
+		for _, message := range file.Messages {
+			message.ID = namespacedID(namespace, message.ID)
+		}
+	}
+	setMessageSyntax(c.syntaxOf(path), file.Messages...)
+	return addMessages(file.Tag, path, file.Messages...)
+}
+
+// parseMessageFile parses the message file with the nested key separator of c.
+// Files defining message variants are flattened with ".", the separator used by the bundle.
+func parseMessageFile(data []byte, path string, c *config) (*i18n.MessageFile, error) {
+	if c.nestedKeySeparator != "" {
+		return parseNestedMessageFile(data, path, c.unmarshalFuncMap, c.nestedKeySeparator)
+	}
+	file, raw, err := unmarshalMessageFile(data, path, c.unmarshalFuncMap)
+	if err != nil {
+		return nil, err
+	}
+	if !hasVariants(raw) {
+		return i18n.ParseMessageFileBytes(data, path, c.unmarshalFuncMap)
+	}
+	file.Messages, err = flattenMessages("", raw, ".")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// readFile reads the file from fsys, or from disk if fsys is nil.
+func readFile(fsys fs.FS, path string) ([]byte, error) {
+	if fsys != nil {
+		return fs.ReadFile(fsys, path)
+	}
+	return os.ReadFile(path)
+}
+
+// catalogIDs returns the sorted message IDs loaded for the language.
+func catalogIDs(tag language.Tag) []string {
+	ids := make([]string, 0, len(catalog[tag]))
+	for id := range catalog[tag] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// pluralForms returns the CLDR plural categories used by the language for integer and decimal counts,
+// in CLDR order. "other" is always included.
+func pluralForms(tag language.Tag) []plural.Form {
+	seen := map[plural.Form]bool{plural.Other: true}
+	for _, n := range integerPluralSamples {
+		seen[plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0)] = true
+	}
+	for _, form := range decimalPluralForms(tag) {
+		seen[form] = true
+	}
+	var forms []plural.Form
+	for _, form := range []plural.Form{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other} {
+		if seen[form] {
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+// integerPluralSamples are counts reaching every CLDR plural category used for integers,
+// millions included for the "many" category of languages like French.
+var integerPluralSamples = func() []int {
+	samples := make([]int, 0, 1003)
+	for n := 0; n <= 1000; n++ {
+		samples = append(samples, n)
+	}
+	return append(samples, 1_000_000, 2_000_000)
+}()
+
+// decimalPluralForms returns the plural categories of the language for decimal counts such as 1.5,
+// which some languages use for categories integers never get, e.g. Lithuanian "many".
+func decimalPluralForms(tag language.Tag) []plural.Form {
+	var forms []plural.Form
+	for _, i := range []int{0, 1, 2, 5} {
+		for _, f := range []int{1, 5} {
+			forms = append(forms, plural.Cardinal.MatchPlural(tag, i, 1, 1, f, f))
+		}
+	}
+	return forms
+}
+
+// pluralFormNames maps the CLDR plural categories to the message keys used in message files.
+var pluralFormNames = map[plural.Form]string{
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+	plural.Other: "other",
+}
+
+func getPluralForm(message *i18n.Message, form plural.Form) string {
+	switch form {
+	case plural.Zero:
+		return message.Zero
+	case plural.One:
+		return message.One
+	case plural.Two:
+		return message.Two
+	case plural.Few:
+		return message.Few
+	case plural.Many:
+		return message.Many
+	default:
+		return message.Other
+	}
+}
+
+func setPluralForm(message *i18n.Message, form plural.Form, text string) {
+	switch form {
+	case plural.Zero:
+		message.Zero = text
+	case plural.One:
+		message.One = text
+	case plural.Two:
+		message.Two = text
+	case plural.Few:
+		message.Few = text
+	case plural.Many:
+		message.Many = text
+	case plural.Other:
+		message.Other = text
+	}
+}
+
+// isPluralMessage reports whether the message defines any form other than "other".
+func isPluralMessage(message *i18n.Message) bool {
+	return message.Zero != "" || message.One != "" || message.Two != "" || message.Few != "" || message.Many != ""
+}
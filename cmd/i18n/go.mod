@@ -0,0 +1,19 @@
+module github.com/afkdevs/go-i18n/cmd/i18n
+
+go 1.23.0
+
+replace github.com/afkdevs/go-i18n => ../..
+
+require (
+	github.com/BurntSushi/toml v1.6.0
+	github.com/afkdevs/go-i18n v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.9.0
+	golang.org/x/text v0.27.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/nicksnyder/go-i18n/v2 v2.6.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+)
@@ -0,0 +1,165 @@
+// Command i18n exchanges the messages of a go-i18n project with translators and frontend clients.
+//
+// Usage:
+//
+//	i18n xliff export -source en -target id [-version 2.0] [-o messages.id.xlf] locales/en.yaml locales/id.yaml
+//	i18n xliff import -file locales/id.yaml messages.id.xlf
+//	i18n export i18next -lang id [-default en] [-prefix settings.] [-o id.json] locales/en.yaml locales/id.yaml
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/afkdevs/go-i18n"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+const usage = `usage:
+  i18n xliff export -source <lang> -target <lang> [-version 1.2|2.0] [-o file] <message files...>
+  i18n xliff import -file <message file> [xliff file]
+  i18n export i18next -lang <lang> [-default <lang>] [-prefix <prefix>] [-o file] <message files...>
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) < 2 {
+		return errors.New(usage)
+	}
+	switch args[0] + " " + args[1] {
+	case "xliff export":
+		return xliffExport(args[2:], stdout)
+	case "xliff import":
+		return xliffImport(args[2:], stdin)
+	case "export i18next":
+		return exportI18Next(args[2:], stdout)
+	default:
+		return errors.New(usage)
+	}
+}
+
+func xliffExport(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("xliff export", flag.ContinueOnError)
+	source := flags.String("source", "en", "source language")
+	target := flags.String("target", "", "target language")
+	version := flags.String("version", string(i18n.XLIFF20), "XLIFF version (1.2 or 2.0)")
+	output := flags.String("o", "", "output file (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *target == "" {
+		return errors.New("xliff export: -target is required")
+	}
+	sourceTag, err := language.Parse(*source)
+	if err != nil {
+		return err
+	}
+	targetTag, err := language.Parse(*target)
+	if err != nil {
+		return err
+	}
+	if err := initBundle(sourceTag, flags.Args()); err != nil {
+		return err
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	return i18n.ExportXLIFF(w, sourceTag, targetTag, i18n.XLIFFVersion(*version))
+}
+
+func xliffImport(args []string, stdin io.Reader) error {
+	flags := flag.NewFlagSet("xliff import", flag.ContinueOnError)
+	path := flags.String("file", "", "message file to write the translations to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *path == "" {
+		return errors.New("xliff import: -file is required")
+	}
+
+	r := stdin
+	if flags.NArg() > 0 {
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	return i18n.ImportXLIFF(r, *path)
+}
+
+func exportI18Next(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export i18next", flag.ContinueOnError)
+	defaultLanguage := flags.String("default", "en", "default language")
+	lang := flags.String("lang", "", "language to export")
+	prefix := flags.String("prefix", "", "export only message IDs with this prefix")
+	output := flags.String("o", "", "output file (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *lang == "" {
+		return errors.New("export i18next: -lang is required")
+	}
+	defaultTag, err := language.Parse(*defaultLanguage)
+	if err != nil {
+		return err
+	}
+	tag, err := language.Parse(*lang)
+	if err != nil {
+		return err
+	}
+	if err := initBundle(defaultTag, flags.Args()); err != nil {
+		return err
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	return i18n.ExportI18Next(w, tag, *prefix)
+}
+
+// initBundle initializes the i18n package with the message files.
+// Gettext catalogs are recognized by their .po and .mo extensions.
+func initBundle(defaultLanguage language.Tag, paths []string) error {
+	opts := []i18n.Option{
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithUnmarshalFunc("yml", yaml.Unmarshal),
+		i18n.WithUnmarshalFunc("toml", toml.Unmarshal),
+	}
+	for _, path := range paths {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".po", ".mo":
+			opts = append(opts, i18n.WithGettextFile(path))
+		default:
+			opts = append(opts, i18n.WithTranslationFile(path))
+		}
+	}
+	return i18n.Init(defaultLanguage, opts...)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("xliff export", func(t *testing.T) {
+		var stdout bytes.Buffer
+		err := run([]string{"xliff", "export", "-source", "en", "-target", "id", "-version", "1.2",
+			"../../testdata/en.yaml", "../../testdata/id.yaml"}, nil, &stdout)
+		require.NoError(t, err)
+		assert.Contains(t, stdout.String(), `<trans-unit id="welcome">`)
+		assert.Contains(t, stdout.String(), `<target>Selamat datang</target>`)
+		assert.Contains(t, stdout.String(), `<note>Title of the home page</note>`)
+	})
+	t.Run("xliff export without target", func(t *testing.T) {
+		err := run([]string{"xliff", "export", "../../testdata/en.yaml"}, nil, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+	t.Run("xliff import", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "id.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("welcome: Halo\n"), 0o644))
+
+		stdin := strings.NewReader(`<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="id">
+  <file id="messages">
+    <unit id="welcome"><segment><source>Welcome</source><target>Selamat datang</target></segment></unit>
+  </file>
+</xliff>`)
+		err := run([]string{"xliff", "import", "-file", path}, stdin, &bytes.Buffer{})
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "welcome: Selamat datang\n", string(data))
+	})
+	t.Run("export i18next", func(t *testing.T) {
+		var stdout bytes.Buffer
+		err := run([]string{"export", "i18next", "-lang", "id", "-prefix", "hello_name",
+			"../../testdata/en.yaml", "../../testdata/id.yaml"}, nil, &stdout)
+		require.NoError(t, err)
+		assert.Equal(t, "{\n  \"hello_name\": \"Halo, {{name}}\",\n  \"hello_name_age\": \"Halo, {{name}}! Kamu berumur {{age}} tahun.\"\n}\n", stdout.String())
+	})
+	t.Run("unknown command", func(t *testing.T) {
+		err := run([]string{"unknown"}, nil, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
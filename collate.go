@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Sort sorts the strings in place with the collation of the language messages are served in for the context.
+//
+// Example:
+//
+//	countries := []string{"Österreich", "Deutschland", "Zypern"}
+//	i18n.Sort(ctx, countries) // [Deutschland Österreich Zypern] in German
+func Sort(ctx context.Context, items []string) {
+	SortFunc(ctx, items, func(item string) string { return item })
+}
+
+// SortFunc sorts the items in place by the text of key with the collation of the language messages are served in
+// for the context. The sort is stable, and key is called once per item.
+//
+// Example:
+//
+//	i18n.SortFunc(ctx, products, func(p Product) string { return p.Name })
+func SortFunc[T any](ctx context.Context, items []T, key func(T) string) {
+	sortByKeys(resolvedLanguage(ctx), items, func(i int) string { return key(items[i]) })
+}
+
+// SortByMessage sorts the message IDs in place by their translated messages in the language of the context,
+// e.g. for dropdowns built from message IDs. The options apply to every message.
+//
+// Example:
+//
+//	ids := []string{"country.de", "country.at", "country.cy"}
+//	i18n.SortByMessage(ctx, ids)
+func SortByMessage(ctx context.Context, ids []string, opts ...any) {
+	if bundle == nil {
+		return
+	}
+	lang := extractLanguageFunc(ctx)
+	sortByKeys(resolvedLanguage(ctx), ids, func(i int) string { return localize(ctx, lang, ids[i], opts...) })
+}
+
+// sortByKeys sorts the items stably by the collation keys of the texts returned by text for each index.
+func sortByKeys[T any](tag language.Tag, items []T, text func(i int) string) {
+	collator := collate.New(tag)
+	var buf collate.Buffer
+	type keyed struct {
+		item T
+		key  []byte
+	}
+	keyedItems := make([]keyed, len(items))
+	for i, item := range items {
+		keyedItems[i] = keyed{item: item, key: collator.KeyFromString(&buf, text(i))}
+	}
+	slices.SortStableFunc(keyedItems, func(a, b keyed) int {
+		return bytes.Compare(a.key, b.key)
+	})
+	for i, k := range keyedItems {
+		items[i] = k.item
+	}
+}
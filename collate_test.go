@@ -0,0 +1,67 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/de.yaml", "testdata/sv.yaml"))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		expected []string
+	}{
+		{name: "english", lang: "en", expected: []string{"apfel", "Äpfel", "Birne", "Zitrone"}},
+		{name: "german", lang: "de-AT", expected: []string{"apfel", "Äpfel", "Birne", "Zitrone"}},
+		{name: "swedish", lang: "sv", expected: []string{"apfel", "Birne", "Zitrone", "Äpfel"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items := []string{"Zitrone", "Äpfel", "Birne", "apfel"}
+			i18n.Sort(i18n.SetLangToContext(context.Background(), tc.lang), items)
+			assert.Equal(t, tc.expected, items)
+		})
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/de.yaml", "testdata/sv.yaml"))
+
+	type product struct {
+		name string
+		sku  int
+	}
+	products := []product{{"Öl", 1}, {"Zucker", 2}, {"Ofen", 3}, {"Öl", 4}}
+	i18n.SortFunc(i18n.SetLangToContext(context.Background(), "sv"), products, func(p product) string { return p.name })
+	assert.Equal(t, []product{{"Ofen", 3}, {"Zucker", 2}, {"Öl", 1}, {"Öl", 4}}, products)
+
+	i18n.SortFunc(i18n.SetLangToContext(context.Background(), "de"), products, func(p product) string { return p.name })
+	assert.Equal(t, []product{{"Ofen", 3}, {"Öl", 1}, {"Öl", 4}, {"Zucker", 2}}, products)
+}
+
+func TestSortByMessage(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/de.yaml", "testdata/sv.yaml"))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		expected []string
+	}{
+		{name: "english", lang: "en", expected: []string{"country.at", "country.de", "country.is", "country.se"}},
+		{name: "german", lang: "de", expected: []string{"country.de", "country.is", "country.at", "country.se"}},
+		{name: "swedish", lang: "sv", expected: []string{"country.is", "country.se", "country.de", "country.at"}},
+		{name: "fallback language", lang: "fr", expected: []string{"country.at", "country.de", "country.is", "country.se"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ids := []string{"country.se", "country.at", "country.is", "country.de"}
+			i18n.SortByMessage(i18n.SetLangToContext(context.Background(), tc.lang), ids)
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
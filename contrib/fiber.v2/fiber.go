@@ -34,6 +34,27 @@ func New(opts ...Option) fiber.Handler {
 	}
 }
 
+// localizerKey is the key of the localizer in the Fiber locals.
+const localizerKey = "i18n.localizer"
+
+// Localizer returns the localizer of the language of the user context. It is resolved by the first call
+// for the request and stored in the locals, later calls reuse it.
+//
+// Example:
+//
+//	app.Get("/cart", func(c *fiber.Ctx) error {
+//		l := fiberi18n.Localizer(c)
+//		return c.SendString(l.TN("items", 3))
+//	})
+func Localizer(c *fiber.Ctx) *i18n.Localizer {
+	if localizer, ok := c.Locals(localizerKey).(*i18n.Localizer); ok {
+		return localizer
+	}
+	localizer := i18n.FromContext(c.UserContext())
+	c.Locals(localizerKey, localizer)
+	return localizer
+}
+
 // TCtx is an alias for i18n.TCtx that uses the Fiber context.
 func TCtx(c *fiber.Ctx, id string, opts ...any) string {
 	ctx := c.UserContext()
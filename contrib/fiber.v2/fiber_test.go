@@ -1,6 +1,7 @@
 package fiber_test
 
 import (
+	"io"
 	"net/http/httptest"
 	"testing"
 
@@ -111,3 +112,59 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalizer(t *testing.T) {
+	err := i18n.Init(language.English,
+		i18n.WithTranslationFile("../../testdata/en.yaml", "../../testdata/id.yaml"),
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+	)
+	require.NoError(t, err, "Failed to initialize i18n")
+
+	handler := func(c *fiber.Ctx) error {
+		l := fiberi18n.Localizer(c)
+		return c.SendString(l.Lang().String() + ": " + l.TN("items", 3))
+	}
+	app := fiber.New()
+	app.Get("/without-middleware", handler)
+	app.Use(fiberi18n.New())
+	app.Get("/items", handler)
+
+	testCases := []struct {
+		name       string
+		path       string
+		acceptLang string
+		expected   string
+	}{
+		{
+			name:       "when request has header Accept-Language with id-ID",
+			path:       "/items",
+			acceptLang: "id-ID",
+			expected:   "id: 3 barang",
+		},
+		{
+			name:     "when request not has header Accept-Language",
+			path:     "/items",
+			expected: "en: 3 items",
+		},
+		{
+			name:       "when middleware is not used",
+			path:       "/without-middleware",
+			acceptLang: "id-ID",
+			expected:   "en: 3 items",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.path, nil)
+			if tc.acceptLang != "" {
+				req.Header.Set("Accept-Language", tc.acceptLang)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err, "Failed to test request")
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(body))
+		})
+	}
+}
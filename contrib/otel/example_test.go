@@ -0,0 +1,24 @@
+package otel_test
+
+import (
+	"github.com/afkdevs/go-i18n"
+	i18notel "github.com/afkdevs/go-i18n/contrib/otel"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func Example() {
+	// Uses the global meter provider, set it up with otel.SetMeterProvider before.
+	observer, err := i18notel.New()
+	if err != nil {
+		panic(err)
+	}
+
+	if err := i18n.Init(language.English,
+		i18n.WithTranslationFile("../../testdata/en.yaml", "../../testdata/id.yaml"),
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithLookupObserver(observer),
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,27 @@
+package otel
+
+import "go.opentelemetry.io/otel/metric"
+
+type config struct {
+	meterProvider metric.MeterProvider
+}
+
+// Option is a function that configures the Observer.
+type Option func(*config)
+
+func newConfig(opts ...Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithMeterProvider sets the meter provider of the metrics.
+//
+// Defaults to the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
@@ -0,0 +1,125 @@
+// Package otel records OpenTelemetry metrics and span events for i18n message lookups.
+package otel
+
+import (
+	"context"
+
+	"github.com/afkdevs/go-i18n"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/afkdevs/go-i18n/contrib/otel"
+
+// Attribute keys of the metrics and span events.
+const (
+	MessageIDKey        = attribute.Key("i18n.message.id")
+	LanguageKey         = attribute.Key("i18n.language")
+	FallbackLanguageKey = attribute.Key("i18n.fallback.language")
+)
+
+// Span event names.
+const (
+	FallbackEventName = "i18n.fallback"
+	MissingEventName  = "i18n.missing"
+)
+
+// Observer records OpenTelemetry metrics for message lookups, and adds span events to the active span
+// of the lookup context when a message falls back to another language or is missing.
+//
+// Span events have the first requested language as i18n.language. Metrics have the loaded language matched
+// for the requested languages instead, so clients can't add series with arbitrary Accept-Language headers:
+//   - i18n.lookups: the number of lookups
+//   - i18n.fallbacks: the number of lookups served in a fallback language, with i18n.fallback.language
+//   - i18n.misses: the number of lookups of missing messages
+//   - i18n.lookup.duration: the duration of lookups in seconds
+type Observer struct {
+	lookups  metric.Int64Counter
+	fallback metric.Int64Counter
+	misses   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var _ i18n.LookupObserver = (*Observer)(nil)
+
+// New creates an Observer. Pass it to i18n.Init with i18n.WithLookupObserver.
+//
+// Example:
+//
+//	observer, err := otel.New()
+//	if err != nil {
+//		panic(err)
+//	}
+//	err = i18n.Init(language.English, i18n.WithLookupObserver(observer))
+func New(opts ...Option) (*Observer, error) {
+	cfg := newConfig(opts...)
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	var (
+		o   Observer
+		err error
+	)
+	if o.lookups, err = meter.Int64Counter("i18n.lookups",
+		metric.WithDescription("Number of message lookups."),
+		metric.WithUnit("{lookup}"),
+	); err != nil {
+		return nil, err
+	}
+	if o.fallback, err = meter.Int64Counter("i18n.fallbacks",
+		metric.WithDescription("Number of message lookups served in a fallback language."),
+		metric.WithUnit("{lookup}"),
+	); err != nil {
+		return nil, err
+	}
+	if o.misses, err = meter.Int64Counter("i18n.misses",
+		metric.WithDescription("Number of lookups of missing messages."),
+		metric.WithUnit("{lookup}"),
+	); err != nil {
+		return nil, err
+	}
+	if o.duration, err = meter.Float64Histogram("i18n.lookup.duration",
+		metric.WithDescription("Duration of message lookups."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ObserveLookup implements i18n.LookupObserver.
+func (o *Observer) ObserveLookup(ctx context.Context, event i18n.LookupEvent) {
+	// Metric attributes use the matched language, bounded by the loaded languages,
+	// span events the requested language.
+	matched := event.Matched.String()
+	requested := matched
+	if len(event.Languages) > 0 {
+		requested = event.Languages[0].String()
+	}
+	attrs := metric.WithAttributes(LanguageKey.String(matched))
+
+	o.lookups.Add(ctx, 1, attrs)
+	o.duration.Record(ctx, event.Duration.Seconds(), attrs)
+
+	span := trace.SpanFromContext(ctx)
+	switch {
+	case event.Missing:
+		o.misses.Add(ctx, 1, attrs)
+		span.AddEvent(MissingEventName, trace.WithAttributes(
+			MessageIDKey.String(event.ID),
+			LanguageKey.String(requested),
+		))
+	case event.Fallback:
+		fallback := event.Language.String()
+		o.fallback.Add(ctx, 1, metric.WithAttributes(LanguageKey.String(matched), FallbackLanguageKey.String(fallback)))
+		span.AddEvent(FallbackEventName, trace.WithAttributes(
+			MessageIDKey.String(event.ID),
+			LanguageKey.String(requested),
+			FallbackLanguageKey.String(fallback),
+		))
+	}
+}
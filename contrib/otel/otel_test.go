@@ -0,0 +1,81 @@
+package otel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	i18notel "github.com/afkdevs/go-i18n/contrib/otel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestObserver(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	observer, err := i18notel.New(i18notel.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+	require.NoError(t, err)
+
+	err = i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("../../testdata/en.yaml", "../../testdata/id.yaml"),
+		i18n.WithLookupObserver(observer),
+	)
+	require.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+	ctx, span := tracer.Start(i18n.SetLangToContext(context.Background(), "id-ID"), "request")
+	i18n.GetCtx(ctx, "hello")
+	i18n.GetCtx(ctx, "hello_english")
+	i18n.GetCtx(ctx, "unknown")
+	i18n.T("hello")
+	span.End()
+
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+	require.Len(t, metrics.ScopeMetrics, 1)
+	sums := make(map[string]map[string]int64)
+	var histogramCount uint64
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			sums[m.Name] = make(map[string]int64)
+			for _, point := range data.DataPoints {
+				sums[m.Name][point.Attributes.Encoded(attribute.DefaultEncoder())] = point.Value
+			}
+		case metricdata.Histogram[float64]:
+			assert.Equal(t, "i18n.lookup.duration", m.Name)
+			for _, point := range data.DataPoints {
+				histogramCount += point.Count
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int64{"i18n.language=id": 3, "i18n.language=en": 1}, sums["i18n.lookups"])
+	assert.Equal(t, map[string]int64{"i18n.fallback.language=en,i18n.language=id": 1}, sums["i18n.fallbacks"])
+	assert.Equal(t, map[string]int64{"i18n.language=id": 1}, sums["i18n.misses"])
+	assert.Equal(t, uint64(4), histogramCount)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	events := spans[0].Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, i18notel.FallbackEventName, events[0].Name)
+	assert.Equal(t, []attribute.KeyValue{
+		i18notel.MessageIDKey.String("hello_english"),
+		i18notel.LanguageKey.String("id-ID"),
+		i18notel.FallbackLanguageKey.String("en"),
+	}, events[0].Attributes)
+	assert.Equal(t, i18notel.MissingEventName, events[1].Name)
+	assert.Equal(t, []attribute.KeyValue{
+		i18notel.MessageIDKey.String("unknown"),
+		i18notel.LanguageKey.String("id-ID"),
+	}, events[1].Attributes)
+}
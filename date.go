@@ -0,0 +1,288 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// DateStyle is the CLDR style of a formatted date or time.
+type DateStyle string
+
+const (
+	// DateShort is the short style, e.g. 1/2/06 or 3:04 PM.
+	DateShort DateStyle = "short"
+	// DateMedium is the medium style, e.g. Jan 2, 2006 or 3:04:05 PM.
+	DateMedium DateStyle = "medium"
+	// DateLong is the long style, e.g. January 2, 2006.
+	DateLong DateStyle = "long"
+	// DateFull is the full style, e.g. Monday, January 2, 2006.
+	DateFull DateStyle = "full"
+)
+
+// Message IDs of the locale data used to format dates. Messages with these IDs in the loaded files
+// override the built-in data, which covers English, Indonesian, German, Spanish, French, Japanese and Chinese.
+const (
+	// dateMessagePrefix is followed by the style, e.g. "i18n.date.long": "d MMMM y".
+	dateMessagePrefix = "i18n.date."
+	// timeMessagePrefix is followed by the style, e.g. "i18n.time.short": "HH.mm".
+	timeMessagePrefix = "i18n.time."
+	// Comma-separated month, weekday (starting on Sunday) and AM/PM names.
+	monthsWideMessageID        = "i18n.date.months.wide"
+	monthsAbbreviatedMessageID = "i18n.date.months.abbreviated"
+	daysWideMessageID          = "i18n.date.days.wide"
+	daysAbbreviatedMessageID   = "i18n.date.days.abbreviated"
+	dayPeriodsMessageID        = "i18n.date.dayPeriods"
+	// relativeMessagePrefix is followed by the unit and direction, e.g. "i18n.relative.day.past",
+	// plural messages with the amount as {{.Count}}.
+	relativeMessagePrefix = "i18n.relative."
+	relativeNowMessageID  = "i18n.relative.now"
+)
+
+// timeNow returns the current time, relative times are formatted against it.
+var timeNow = time.Now
+
+// FormatDate formats the date with the CLDR pattern of the style in the context language.
+//
+// Example:
+//
+//	i18n.FormatDate(ctx, t, i18n.DateLong) // January 2, 2006 in English, 2 Januari 2006 in Indonesian
+func FormatDate(ctx context.Context, t time.Time, style DateStyle) string {
+	return formatDate(contextLanguage(ctx), t, dateMessagePrefix, style)
+}
+
+// FormatTime formats the time of day with the CLDR pattern of the style in the context language.
+//
+// Example:
+//
+//	i18n.FormatTime(ctx, t, i18n.DateShort) // 3:04 PM in English, 15.04 in Indonesian
+func FormatTime(ctx context.Context, t time.Time, style DateStyle) string {
+	return formatDate(contextLanguage(ctx), t, timeMessagePrefix, style)
+}
+
+// FormatRelativeTime formats the time relative to now in the context language,
+// using the largest unit that fits, from seconds to years.
+//
+// Example:
+//
+//	i18n.FormatRelativeTime(ctx, time.Now().Add(-72*time.Hour)) // 3 days ago in English, 3 hari yang lalu in Indonesian
+func FormatRelativeTime(ctx context.Context, t time.Time) string {
+	return formatRelativeTime(contextLanguage(ctx), t)
+}
+
+func formatDate(tag language.Tag, t time.Time, prefix string, style DateStyle) string {
+	pattern := localeText(tag, prefix+string(style), 0)
+	if pattern == "" {
+		pattern = localeText(tag, prefix+string(DateMedium), 0)
+	}
+	return formatDatePattern(tag, t, pattern)
+}
+
+var relativeUnits = []struct {
+	name string
+	size time.Duration
+}{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+func formatRelativeTime(tag language.Tag, t time.Time) string {
+	d := t.Sub(timeNow())
+	direction := "future"
+	if d < 0 {
+		d, direction = -d, "past"
+	}
+	for _, unit := range relativeUnits {
+		if count := int(d / unit.size); count > 0 {
+			return localeText(tag, relativeMessagePrefix+unit.name+"."+direction, count)
+		}
+	}
+	return localeText(tag, relativeNowMessageID, 0)
+}
+
+// localeText returns the locale data message with the ID for the language, with count as {{.Count}}.
+//
+// Messages of the loaded files for the language win over the built-in data,
+// and languages without built-in data use English.
+func localeText(tag language.Tag, id string, count int) string {
+	message := localeMessage(tag, id)
+	if message == nil {
+		return ""
+	}
+	form := plural.Cardinal.MatchPlural(tag, count, 0, 0, 0, 0)
+	text := getPluralForm(message, form)
+	if text == "" {
+		text = message.Other
+	}
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	parsed, err := (&template.TextParser{}).Parse(text, "", "")
+	if err != nil {
+		return text
+	}
+	result, err := parsed.Execute(map[string]any{"Count": count})
+	if err != nil {
+		return text
+	}
+	return result
+}
+
+func localeMessage(tag language.Tag, id string) *i18n.Message {
+	if message := languageLocaleMessage(tag, id); message != nil {
+		return message
+	}
+	if base, _ := tag.Base(); base.String() != "en" {
+		if _, logged := localeFallbacks.LoadOrStore(base, true); !logged {
+			logAttrs(context.Background(), slog.LevelWarn, "i18n: no locale data for language, using English",
+				slog.String("language", base.String()), slog.String("id", id))
+		}
+	}
+	return builtinLocaleData["en"][id]
+}
+
+// localeFallbacks are the base languages a fallback to the English locale data was logged for,
+// so the fallback is logged once per language.
+var localeFallbacks sync.Map
+
+// languageLocaleMessage returns the locale data message of the language, from the loaded messages
+// of the same base language or the built-in locale data, or nil if the language has none.
+func languageLocaleMessage(tag language.Tag, id string) *i18n.Message {
+	base, _ := tag.Base()
+	if bundle != nil {
+		matched := matchLanguage(tag)
+		if matchedBase, _ := matched.Base(); matchedBase == base {
+			if entry, ok := catalog[matched][id]; ok {
+				return entry.message
+			}
+		}
+	}
+	return builtinLocaleData[base.String()][id]
+}
+
+// localeNames returns the comma-separated names of the locale data message.
+func localeNames(tag language.Tag, id string) []string {
+	return strings.Split(localeText(tag, id, 0), ",")
+}
+
+// formatDatePattern formats t with a CLDR date pattern such as "EEEE, d MMMM y" or "h:mm a".
+//
+// Text in single quotes is literal, and a doubled single quote is an apostrophe.
+func formatDatePattern(tag language.Tag, t time.Time, pattern string) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if r == '\'' {
+			i++
+			if i < len(runes) && runes[i] == '\'' {
+				b.WriteRune('\'')
+				i++
+				continue
+			}
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						b.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteRune(runes[i])
+				i++
+			}
+			continue
+		}
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			b.WriteRune(r)
+			i++
+			continue
+		}
+		count := 1
+		for i+count < len(runes) && runes[i+count] == r {
+			count++
+		}
+		i += count
+		b.WriteString(formatDateField(tag, t, r, count))
+	}
+	return b.String()
+}
+
+func formatDateField(tag language.Tag, t time.Time, field rune, count int) string {
+	switch field {
+	case 'y':
+		if count == 2 {
+			return fmt.Sprintf("%02d", t.Year()%100)
+		}
+		return padNumber(t.Year(), count)
+	case 'M', 'L':
+		switch count {
+		case 1, 2:
+			return padNumber(int(t.Month()), count)
+		case 3:
+			return localeName(tag, monthsAbbreviatedMessageID, int(t.Month())-1)
+		default:
+			return localeName(tag, monthsWideMessageID, int(t.Month())-1)
+		}
+	case 'd':
+		return padNumber(t.Day(), count)
+	case 'E', 'c':
+		if count < 4 {
+			return localeName(tag, daysAbbreviatedMessageID, int(t.Weekday()))
+		}
+		return localeName(tag, daysWideMessageID, int(t.Weekday()))
+	case 'a':
+		if t.Hour() < 12 {
+			return localeName(tag, dayPeriodsMessageID, 0)
+		}
+		return localeName(tag, dayPeriodsMessageID, 1)
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		return padNumber(hour, count)
+	case 'H':
+		return padNumber(t.Hour(), count)
+	case 'm':
+		return padNumber(t.Minute(), count)
+	case 's':
+		return padNumber(t.Second(), count)
+	case 'z':
+		name, _ := t.Zone()
+		return name
+	}
+	return strings.Repeat(string(field), count)
+}
+
+func localeName(tag language.Tag, id string, i int) string {
+	names := localeNames(tag, id)
+	if i >= len(names) {
+		return ""
+	}
+	return strings.TrimSpace(names[i])
+}
+
+func padNumber(n, width int) string {
+	s := strconv.Itoa(n)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
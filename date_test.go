@@ -0,0 +1,170 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDate(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/fr.yaml"))
+
+	now := time.Date(2024, time.March, 15, 14, 30, 5, 0, time.UTC)
+	i18n.SetNow(now)
+
+	testCases := []struct {
+		name     string
+		language string
+		format   func(ctx context.Context) string
+		expected string
+	}{
+		{
+			name:     "long date",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateLong) },
+			expected: "March 15, 2024",
+		},
+		{
+			name:     "full date",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateFull) },
+			expected: "Friday, March 15, 2024",
+		},
+		{
+			name:     "short date",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateShort) },
+			expected: "3/15/24",
+		},
+		{
+			name:     "long date in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateLong) },
+			expected: "15 Maret 2024",
+		},
+		{
+			name:     "full date in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateFull) },
+			expected: "Jumat, 15 Maret 2024",
+		},
+		{
+			name:     "unknown style uses medium",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, "unknown") },
+			expected: "Mar 15, 2024",
+		},
+		{
+			name:     "short time",
+			format:   func(ctx context.Context) string { return i18n.FormatTime(ctx, now, i18n.DateShort) },
+			expected: "2:30 PM",
+		},
+		{
+			name:     "medium time in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatTime(ctx, now, i18n.DateMedium) },
+			expected: "14.30.05",
+		},
+		{
+			name:     "date overridden by locale file",
+			language: "fr",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateFull) },
+			expected: "vendredi 15 mars 2024",
+		},
+		{
+			name:     "built-in german date",
+			language: "de",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateFull) },
+			expected: "Freitag, 15. März 2024",
+		},
+		{
+			name:     "built-in spanish date",
+			language: "es",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateLong) },
+			expected: "15 de marzo de 2024",
+		},
+		{
+			name:     "built-in japanese date",
+			language: "ja",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateFull) },
+			expected: "2024年3月15日金曜日",
+		},
+		{
+			name:     "built-in chinese time",
+			language: "zh",
+			format:   func(ctx context.Context) string { return i18n.FormatTime(ctx, now, i18n.DateShort) },
+			expected: "14:30",
+		},
+		{
+			name:     "built-in french relative",
+			language: "fr",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(2*time.Hour)) },
+			expected: "dans 2 heures",
+		},
+		{
+			name:     "language without data uses english",
+			language: "ar",
+			format:   func(ctx context.Context) string { return i18n.FormatDate(ctx, now, i18n.DateLong) },
+			expected: "March 15, 2024",
+		},
+		{
+			name:     "relative past",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(-72*time.Hour)) },
+			expected: "3 days ago",
+		},
+		{
+			name:     "relative singular",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(-time.Hour)) },
+			expected: "1 hour ago",
+		},
+		{
+			name:     "relative future",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(2*time.Minute)) },
+			expected: "in 2 minutes",
+		},
+		{
+			name:     "relative now",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now) },
+			expected: "now",
+		},
+		{
+			name:     "relative in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(-72*time.Hour)) },
+			expected: "3 hari yang lalu",
+		},
+		{
+			name:     "relative years",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.AddDate(2, 0, 0)) },
+			expected: "dalam 2 tahun",
+		},
+		{
+			name:     "relative overridden by locale file",
+			language: "fr",
+			format:   func(ctx context.Context) string { return i18n.FormatRelativeTime(ctx, now.Add(-24*time.Hour)) },
+			expected: "il y a 1 jour",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expected, tc.format(ctx))
+		})
+	}
+}
+
+func TestDateTemplateFuncs(t *testing.T) {
+	initI18n(t)
+
+	now := time.Date(2024, time.March, 15, 14, 30, 0, 0, time.UTC)
+	i18n.SetNow(now)
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	assert.Equal(t, "Dibuat pada 15 Maret 2024 pukul 14.30", i18n.GetCtx(ctx, "created", i18n.Param("createdAt", now)))
+	assert.Equal(t, "Created on March 15, 2024 at 2:30 PM", i18n.T("created", i18n.Param("createdAt", now)))
+	assert.Equal(t, "Updated 5 minutes ago", i18n.T("updated", i18n.Param("updatedAt", now.Add(-5*time.Minute))))
+}
@@ -0,0 +1,120 @@
+package i18n
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// TextDirection is the direction text of a language is written in,
+// the value of the dir attribute of HTML elements.
+type TextDirection string
+
+const (
+	// LeftToRight is the direction of languages written from left to right, e.g. English.
+	LeftToRight TextDirection = "ltr"
+	// RightToLeft is the direction of languages written from right to left, e.g. Arabic and Hebrew.
+	RightToLeft TextDirection = "rtl"
+)
+
+const directionCtxKey contextKey = "i18n-direction"
+
+// Bidi control characters isolating text from the surrounding text.
+const (
+	firstStrongIsolate    = "\u2068"
+	leftToRightIsolate    = "\u2066"
+	rightToLeftIsolate    = "\u2067"
+	popDirectionalIsolate = "\u2069"
+)
+
+// rtlScripts are the scripts written from right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true, "Yezi": true,
+}
+
+// DirectionOf returns the text direction of the language, based on its script.
+//
+// Example:
+//
+//	i18n.DirectionOf(language.Arabic)  // rtl
+//	i18n.DirectionOf(language.English) // ltr
+func DirectionOf(tag language.Tag) TextDirection {
+	script, _ := tag.Script()
+	if rtlScripts[script.String()] {
+		return RightToLeft
+	}
+	return LeftToRight
+}
+
+// Direction returns the text direction of the language messages are served in for the context.
+//
+// A requested language that isn't loaded falls back to a loaded language, so the direction is the direction
+// of the fallback language.
+//
+// Example:
+//
+//	fmt.Fprintf(w, `<html dir="%s">`, i18n.Direction(ctx))
+func Direction(ctx context.Context) TextDirection {
+	if direction, ok := ctx.Value(directionCtxKey).(contextDirection); ok && extractLanguageFunc != nil &&
+		direction.lang == extractLanguageFunc(ctx) {
+		return direction.direction
+	}
+	return DirectionOf(resolvedLanguage(ctx))
+}
+
+// contextDirection is the direction added to the context by the middleware with WithDirection,
+// along with the language it was resolved for.
+type contextDirection struct {
+	lang      string
+	direction TextDirection
+}
+
+// withDirection adds the text direction of the language of the context to the context.
+func withDirection(ctx context.Context) context.Context {
+	var lang string
+	if extractLanguageFunc != nil {
+		lang = extractLanguageFunc(ctx)
+	}
+	return context.WithValue(ctx, directionCtxKey, contextDirection{lang: lang, direction: Direction(ctx)})
+}
+
+// Isolate wraps the text in bidi isolation characters, so text of the other direction, e.g. a user name
+// in Arabic inside an English message, doesn't reorder the surrounding text.
+// The direction of the text is detected from its first strong character.
+//
+// Example:
+//
+//	i18n.T("greeting", i18n.Param("name", i18n.Isolate(user.Name)))
+func Isolate(text string) string {
+	return firstStrongIsolate + text + popDirectionalIsolate
+}
+
+// IsolateDirection wraps the text in bidi isolation characters for the direction.
+func IsolateDirection(text string, direction TextDirection) string {
+	if direction == RightToLeft {
+		return rightToLeftIsolate + text + popDirectionalIsolate
+	}
+	return leftToRightIsolate + text + popDirectionalIsolate
+}
+
+// IsolateParams wraps all string params of the message with Isolate, for messages with user-supplied params.
+//
+// Params selecting a variant with Select aren't isolated, and ICU select, plural and number arguments
+// use the value without the isolation.
+//
+// Example:
+//
+//	i18n.T("greeting", i18n.Param("name", user.Name), i18n.IsolateParams())
+func IsolateParams() LocalizeOption {
+	return func(c *localizeConfig) {
+		c.isolateParams = true
+	}
+}
+
+// isIsolated reports whether the text is already wrapped in bidi isolation characters.
+func isIsolated(text string) bool {
+	return strings.HasSuffix(text, popDirectionalIsolate) && (strings.HasPrefix(text, firstStrongIsolate) ||
+		strings.HasPrefix(text, leftToRightIsolate) || strings.HasPrefix(text, rightToLeftIsolate))
+}
@@ -0,0 +1,152 @@
+package i18n_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestDirectionOf(t *testing.T) {
+	testCases := []struct {
+		lang     string
+		expected i18n.TextDirection
+	}{
+		{lang: "en", expected: i18n.LeftToRight},
+		{lang: "id", expected: i18n.LeftToRight},
+		{lang: "ja", expected: i18n.LeftToRight},
+		{lang: "ar", expected: i18n.RightToLeft},
+		{lang: "ar-EG", expected: i18n.RightToLeft},
+		{lang: "he", expected: i18n.RightToLeft},
+		{lang: "fa", expected: i18n.RightToLeft},
+		{lang: "ur", expected: i18n.RightToLeft},
+		{lang: "yi", expected: i18n.RightToLeft},
+		{lang: "dv", expected: i18n.RightToLeft},
+		{lang: "az-Arab", expected: i18n.RightToLeft},
+		{lang: "az", expected: i18n.LeftToRight},
+		{lang: "ar-XB", expected: i18n.RightToLeft},
+		{lang: "en-XA", expected: i18n.LeftToRight},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lang, func(t *testing.T) {
+			assert.Equal(t, tc.expected, i18n.DirectionOf(language.MustParse(tc.lang)))
+		})
+	}
+}
+
+func TestDirection(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ar.yaml", "testdata/he.yaml"), i18n.WithPseudoLocalization(0))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		expected i18n.TextDirection
+	}{
+		{name: "default language", expected: i18n.LeftToRight},
+		{name: "arabic", lang: "ar", expected: i18n.RightToLeft},
+		{name: "regional hebrew", lang: "he-IL", expected: i18n.RightToLeft},
+		{name: "fallback language", lang: "fa", expected: i18n.LeftToRight},
+		{name: "pseudo-locale", lang: "ar-XB", expected: i18n.RightToLeft},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.Direction(ctx))
+			assert.Equal(t, tc.expected, i18n.FromContext(ctx).Dir())
+		})
+	}
+}
+
+func TestMiddlewareWithDirection(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ar.yaml", "testdata/he.yaml"))
+
+	var direction, changed i18n.TextDirection
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		direction = i18n.Direction(r.Context())
+		changed = i18n.Direction(i18n.SetLangToContext(r.Context(), "en"))
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "he")
+	i18n.NewMiddleware(i18n.WithDirection())(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, i18n.RightToLeft, direction)
+	assert.Equal(t, i18n.LeftToRight, changed)
+}
+
+func TestIsolate(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ar.yaml", "testdata/he.yaml"))
+
+	assert.Equal(t, "⁨سارة⁩", i18n.Isolate("سارة"))
+	assert.Equal(t, "⁧سارة⁩", i18n.IsolateDirection("سارة", i18n.RightToLeft))
+	assert.Equal(t, "⁦John⁩", i18n.IsolateDirection("John", i18n.LeftToRight))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		id       string
+		opts     []any
+		expected string
+	}{
+		{
+			name:     "isolated params",
+			id:       "hello_name",
+			opts:     []any{i18n.Param("name", "سارة"), i18n.IsolateParams()},
+			expected: "Hello, ⁨سارة⁩",
+		},
+		{
+			name:     "params isolated once",
+			lang:     "ar",
+			id:       "hello_name",
+			opts:     []any{i18n.IsolateParams(), i18n.Params{"name": i18n.Isolate("John")}},
+			expected: "مرحبا، ⁨John⁩",
+		},
+		{
+			name:     "non-string params are kept",
+			id:       "hello_name",
+			opts:     []any{i18n.Param("name", 42), i18n.IsolateParams()},
+			expected: "Hello, 42",
+		},
+		{
+			name:     "isolate template function",
+			id:       "quote",
+			opts:     []any{i18n.Param("name", "سارة")},
+			expected: "⁨سارة⁩ wrote a comment",
+		},
+		{
+			name:     "dir template function",
+			lang:     "ar",
+			id:       "layout",
+			expected: `<html dir="rtl">`,
+		},
+		{
+			name:     "dir template function of the fallback language",
+			lang:     "he",
+			id:       "layout",
+			expected: `<html dir="ltr">`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.GetCtx(ctx, tc.id, tc.opts...))
+		})
+	}
+}
+
+func TestIsolateParamsICU(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English, i18n.WithMessageSyntax(i18n.ICUSyntax))
+	require.NoError(t, err)
+
+	message := i18n.T("invited",
+		i18n.Default("{gender, select, female {She} other {They}} invited {name} to {count, plural, one {# group} other {# groups}}"),
+		i18n.Params{"gender": "female", "name": "سارة", "count": "2"},
+		i18n.IsolateParams(),
+	)
+	assert.Equal(t, "She invited ⁨سارة⁩ to 2 groups", message)
+}
@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// languageNamePrefix is the prefix of the locale data messages naming languages, e.g. "i18n.language.id".
+const languageNamePrefix = "i18n.language."
+
+// LanguageName is a loaded language with its names, for language pickers.
+type LanguageName struct {
+	// Tag is the language.
+	Tag language.Tag
+	// Name is the name of the language in the language of the context, e.g. "Indonesian".
+	Name string
+	// NativeName is the name of the language in the language itself, e.g. "Bahasa Indonesia".
+	NativeName string
+}
+
+// DisplayName returns the name of the language in the language of the context.
+//
+// Names come from golang.org/x/text/language/display and can be overridden with
+// "i18n.language.<tag>" messages in the locale files, e.g. "i18n.language.pt-BR".
+//
+// Example:
+//
+//	i18n.DisplayName(ctx, language.Indonesian) // Indonesian in English, Bahasa Indonesia in Indonesian
+func DisplayName(ctx context.Context, tag language.Tag) string {
+	return languageName(resolvedLanguage(ctx), tag)
+}
+
+// NativeName returns the name of the language in the language itself.
+//
+// Example:
+//
+//	i18n.NativeName(language.Indonesian) // Bahasa Indonesia
+//	i18n.NativeName(language.Japanese)   // 日本語
+func NativeName(tag language.Tag) string {
+	return languageName(tag, tag)
+}
+
+// LanguageNames returns the loaded languages with their names in the language of the context
+// and their native names, sorted by name with the collation of the language of the context.
+//
+// Example:
+//
+//	for _, lang := range i18n.LanguageNames(ctx) {
+//		fmt.Printf("<option value=%q>%s</option>\n", lang.Tag, lang.NativeName)
+//	}
+func LanguageNames(ctx context.Context) []LanguageName {
+	viewer := resolvedLanguage(ctx)
+	tags := Languages()
+	names := make([]LanguageName, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, LanguageName{Tag: tag, Name: languageName(viewer, tag), NativeName: NativeName(tag)})
+	}
+	sortByKeys(viewer, names, func(i int) string { return names[i].Name })
+	return names
+}
+
+// languageName returns the name of the language in the viewer language.
+func languageName(viewer, tag language.Tag) string {
+	if message := languageLocaleMessage(viewer, languageNamePrefix+tag.String()); message != nil {
+		return message.Other
+	}
+	if name := display.Tags(viewer).Name(tag); name != "" {
+		return name
+	}
+	if name := display.English.Tags().Name(tag); name != "" {
+		return name
+	}
+	return tag.String()
+}
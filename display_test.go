@@ -0,0 +1,91 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestDisplayName(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ja.yaml", "testdata/de.yaml"))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		tag      language.Tag
+		expected string
+	}{
+		{name: "english viewer", lang: "en", tag: language.Indonesian, expected: "Indonesian"},
+		{name: "indonesian viewer", lang: "id", tag: language.English, expected: "Inggris"},
+		{name: "built-in override", lang: "id", tag: language.Indonesian, expected: "Bahasa Indonesia"},
+		{name: "message override", lang: "en", tag: language.BrazilianPortuguese, expected: "Portuguese (Brazil)"},
+		{name: "regional viewer", lang: "ja-JP", tag: language.German, expected: "ドイツ語"},
+		{name: "unsupported viewer falls back to default language", lang: "fr", tag: language.German, expected: "German"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.DisplayName(ctx, tc.tag))
+		})
+	}
+}
+
+func TestNativeName(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ja.yaml", "testdata/de.yaml"))
+
+	testCases := []struct {
+		tag      language.Tag
+		expected string
+	}{
+		{tag: language.English, expected: "English"},
+		{tag: language.Indonesian, expected: "Bahasa Indonesia"},
+		{tag: language.Japanese, expected: "日本語"},
+		{tag: language.German, expected: "Deutsch"},
+		{tag: language.Arabic, expected: "العربية"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.tag.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, i18n.NativeName(tc.tag))
+		})
+	}
+}
+
+func TestLanguageNames(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/ja.yaml", "testdata/de.yaml"))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		expected []i18n.LanguageName
+	}{
+		{
+			name: "english viewer",
+			lang: "en",
+			expected: []i18n.LanguageName{
+				{Tag: language.English, Name: "English", NativeName: "English"},
+				{Tag: language.German, Name: "German", NativeName: "Deutsch"},
+				{Tag: language.Indonesian, Name: "Indonesian", NativeName: "Bahasa Indonesia"},
+				{Tag: language.Japanese, Name: "Japanese", NativeName: "日本語"},
+			},
+		},
+		{
+			name: "indonesian viewer",
+			lang: "id",
+			expected: []i18n.LanguageName{
+				{Tag: language.Indonesian, Name: "Bahasa Indonesia", NativeName: "Bahasa Indonesia"},
+				{Tag: language.English, Name: "Inggris", NativeName: "English"},
+				{Tag: language.Japanese, Name: "Jepang", NativeName: "日本語"},
+				{Tag: language.German, Name: "Jerman", NativeName: "Deutsch"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.LanguageNames(ctx))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Reset restores the package to its uninitialized state.
+//
+// Tests that call Init register it with t.Cleanup so they don't leak state into tests
+// that expect an uninitialized package.
+func Reset() {
+	bundle = nil
+	defaultLanguage = language.Und
+	missingTranslationHandler = nil
+	extractLanguageFunc = nil
+	missingTranslationReporter = nil
+	lookupObserver = nil
+	namespaceFallback = ""
+	messageSyntax = TemplateSyntax
+	convertMeasurement = false
+	languageMatcher.Store(nil)
+	catalog = nil
+	timeNow = time.Now
+	logger = nil
+	localizers = nil
+	compiledTemplates = nil
+	fileConfig = nil
+	pseudoBundle, pseudoExpansion = nil, 0
+	messageParsers.Clear()
+	localeFallbacks.Clear()
+
+	tenants.Lock()
+	tenants.overrides = nil
+	tenants.Unlock()
+	runtimeMessages.Lock()
+	runtimeMessages.overrides = nil
+	runtimeMessages.Unlock()
+}
+
+// SetNow sets the current time used to format relative times until Reset.
+func SetNow(now time.Time) {
+	timeNow = func() time.Time { return now }
+}
+
+// LocalizerCacheLen returns the number of cached localizers.
+func LocalizerCacheLen() int {
+	return localizers.len()
+}
+
+// CompiledTemplateCount returns the number of precompiled templates of the language.
+func CompiledTemplateCount(tag language.Tag) int {
+	return len(compiledTemplates[tag])
+}
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// contextLanguage returns the language of the context the same way GetCtx resolves it:
+// the language extracted from the context, or the default language.
+func contextLanguage(ctx context.Context) language.Tag {
+	if extractLanguageFunc == nil {
+		return GetLanguage(ctx)
+	}
+	if tags := parseLanguages([]string{extractLanguageFunc(ctx)}); len(tags) > 0 {
+		return tags[0]
+	}
+	return defaultLanguage
+}
+
+// templateFuncs returns the functions available in the templates of messages of the language formatting
+// values in the format language. The direction is the direction of the message text.
+func templateFuncs(tag, format language.Tag) template.FuncMap {
+	return template.FuncMap{
+		"number": func(value any) string {
+			return formatNumber(format, value)
+		},
+		"percent": func(value any) string {
+			return formatPercent(format, value)
+		},
+		"currency": func(amount any, code string) string {
+			return formatCurrency(format, amount, code)
+		},
+		"date": func(t time.Time, style string) string {
+			return formatDate(format, t, dateMessagePrefix, DateStyle(style))
+		},
+		"time": func(t time.Time, style string) string {
+			return formatDate(format, t, timeMessagePrefix, DateStyle(style))
+		},
+		"relative": func(t time.Time) string {
+			return formatRelativeTime(format, t)
+		},
+		"list": func(items any, style string) string {
+			return formatList(format, listItems(items), ListStyle(style))
+		},
+		"unit": func(value any, unit, width string) string {
+			return formatUnit(format, value, Unit(unit), UnitWidth(width))
+		},
+		"dir": func() string {
+			return string(DirectionOf(tag))
+		},
+		"isolate": func(text any) string {
+			return Isolate(fmt.Sprint(text))
+		},
+	}
+}
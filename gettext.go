@@ -0,0 +1,537 @@
+package i18n
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// gettextContextSeparator joins msgctxt and msgid into a message ID.
+const gettextContextSeparator = "."
+
+// defaultGettextPluralForms is the rule gettext assumes when a catalog has no Plural-Forms header.
+const defaultGettextPluralForms = "nplurals=2; plural=(n != 1);"
+
+type gettextFSFile struct {
+	fs    fs.FS
+	paths []string
+}
+
+type gettextEntry struct {
+	context     string
+	id          string
+	idPlural    string
+	strs        []string
+	description string
+	fuzzy       bool
+}
+
+type gettextCatalog struct {
+	headers map[string]string
+	entries []gettextEntry
+}
+
+// loadGettextFile reads a PO or MO file and adds its messages to the bundle.
+func loadGettextFile(fsys fs.FS, filePath string) error {
+	data, err := readFile(fsys, filePath)
+	if err != nil {
+		return err
+	}
+
+	var file *gettextCatalog
+	switch strings.ToLower(path.Ext(filepath.ToSlash(filePath))) {
+	case ".po", ".pot":
+		file, err = parsePO(data)
+	case ".mo":
+		file, err = parseMO(data)
+	default:
+		return fmt.Errorf("gettext: unsupported file extension %q", filePath)
+	}
+	if err != nil {
+		return fmt.Errorf("gettext: %s: %w", filePath, err)
+	}
+
+	tag, err := file.language(filePath)
+	if err != nil {
+		return fmt.Errorf("gettext: %s: %w", filePath, err)
+	}
+	messages, err := file.messages(tag)
+	if err != nil {
+		return fmt.Errorf("gettext: %s: %w", filePath, err)
+	}
+	return addMessages(tag, filePath, messages...)
+}
+
+// language returns the catalog language from the Language header,
+// falling back to the file name (id.po, messages.id.po or id/LC_MESSAGES/messages.po).
+func (c *gettextCatalog) language(filePath string) (language.Tag, error) {
+	if lang := c.headers["Language"]; lang != "" {
+		return language.Parse(strings.ReplaceAll(lang, "_", "-"))
+	}
+
+	filePath = filepath.ToSlash(filePath)
+	base := strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+	if i := strings.LastIndex(base, "."); i >= 0 {
+		base = base[i+1:]
+	}
+	if tag, err := language.Parse(strings.ReplaceAll(base, "_", "-")); err == nil {
+		return tag, nil
+	}
+
+	dir := path.Dir(filePath)
+	if path.Base(dir) == "LC_MESSAGES" {
+		lang := path.Base(path.Dir(dir))
+		if tag, err := language.Parse(strings.ReplaceAll(lang, "_", "-")); err == nil {
+			return tag, nil
+		}
+	}
+	return language.Und, errors.New("cannot determine language, set the Language header")
+}
+
+// messages converts the catalog entries into bundle messages.
+//
+// msgctxt becomes a prefix of the message ID and msgstr[n] is mapped to the CLDR plural category
+// selected by the Plural-Forms expression for the catalog language.
+func (c *gettextCatalog) messages(tag language.Tag) ([]*i18n.Message, error) {
+	pluralForms := c.headers["Plural-Forms"]
+	if pluralForms == "" {
+		pluralForms = defaultGettextPluralForms
+	}
+	forms, err := gettextPluralMapping(tag, pluralForms)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*i18n.Message, 0, len(c.entries))
+	for _, entry := range c.entries {
+		if entry.id == "" || !entry.translated() {
+			continue
+		}
+		if entry.fuzzy {
+			logAttrs(context.Background(), slog.LevelWarn, "i18n: skipped fuzzy gettext entry",
+				slog.String("id", entry.id), slog.String("language", tag.String()))
+			continue
+		}
+		id := entry.id
+		if entry.context != "" {
+			id = entry.context + gettextContextSeparator + id
+		}
+		message := &i18n.Message{ID: id, Description: entry.description}
+		if entry.idPlural == "" {
+			message.Other = entry.strs[0]
+		} else {
+			for form, index := range forms {
+				if index < len(entry.strs) {
+					setPluralForm(message, form, entry.strs[index])
+				}
+			}
+			if message.Other == "" {
+				message.Other = entry.strs[len(entry.strs)-1]
+			}
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (e gettextEntry) translated() bool {
+	for _, str := range e.strs {
+		if str != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// gettextPluralMapping evaluates the Plural-Forms expression against counts reaching every CLDR plural
+// category the language uses for integers (gettext has no decimal counts) and returns the msgstr index
+// used for each of them.
+func gettextPluralMapping(tag language.Tag, pluralForms string) (map[plural.Form]int, error) {
+	var expr string
+	for _, part := range strings.Split(pluralForms, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok && strings.TrimSpace(key) == "plural" {
+			expr = value
+		}
+	}
+	if expr == "" {
+		return nil, fmt.Errorf("invalid Plural-Forms header %q", pluralForms)
+	}
+	eval, err := parsePluralExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Plural-Forms header %q: %w", pluralForms, err)
+	}
+
+	forms := make(map[plural.Form]int)
+	for _, n := range integerPluralSamples {
+		form := plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0)
+		if _, ok := forms[form]; !ok {
+			forms[form] = eval(n)
+		}
+	}
+	return forms, nil
+}
+
+func parsePO(data []byte) (*gettextCatalog, error) {
+	file := &gettextCatalog{headers: make(map[string]string)}
+
+	var (
+		entry   gettextEntry
+		current *string
+		started bool
+	)
+	flush := func() {
+		if started {
+			if entry.id == "" && entry.context == "" && len(entry.strs) > 0 {
+				file.parseHeaders(entry.strs[0])
+			} else {
+				file.entries = append(file.entries, entry)
+			}
+		}
+		entry, current, started = gettextEntry{}, nil, false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#~"):
+			// Obsolete entries are ignored.
+		case strings.HasPrefix(line, "#"):
+			if started && current != nil {
+				flush()
+			}
+			switch {
+			case strings.HasPrefix(line, "#."):
+				comment := strings.TrimSpace(line[2:])
+				if entry.description != "" {
+					comment = entry.description + "\n" + comment
+				}
+				entry.description = comment
+			case strings.HasPrefix(line, "#,"):
+				for _, flag := range strings.Split(line[2:], ",") {
+					if strings.TrimSpace(flag) == "fuzzy" {
+						entry.fuzzy = true
+					}
+				}
+			}
+		case strings.HasPrefix(line, `"`):
+			if current == nil {
+				return nil, fmt.Errorf("line %d: unexpected string continuation", lineNumber)
+			}
+			value, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			*current += value
+		default:
+			keyword, rest, _ := strings.Cut(line, " ")
+			value, err := strconv.Unquote(strings.TrimSpace(rest))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			if (keyword == "msgctxt" || keyword == "msgid") && started && current != nil && len(entry.strs) > 0 {
+				flush()
+			}
+			started = true
+			switch {
+			case keyword == "msgctxt":
+				entry.context = value
+				current = &entry.context
+			case keyword == "msgid":
+				entry.id = value
+				current = &entry.id
+			case keyword == "msgid_plural":
+				entry.idPlural = value
+				current = &entry.idPlural
+			case keyword == "msgstr":
+				entry.strs = append(entry.strs, value)
+				current = &entry.strs[len(entry.strs)-1]
+			case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+				index, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+				if err != nil || index != len(entry.strs) {
+					return nil, fmt.Errorf("line %d: unexpected %s", lineNumber, keyword)
+				}
+				entry.strs = append(entry.strs, value)
+				current = &entry.strs[index]
+			default:
+				return nil, fmt.Errorf("line %d: unknown keyword %q", lineNumber, keyword)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return file, nil
+}
+
+const (
+	moMagicLittleEndian = 0x950412de
+	moMagicBigEndian    = 0xde120495
+)
+
+func parseMO(data []byte) (*gettextCatalog, error) {
+	if len(data) < 20 {
+		return nil, errors.New("invalid MO file")
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(data) {
+	case moMagicLittleEndian:
+		order = binary.LittleEndian
+	case moMagicBigEndian:
+		order = binary.BigEndian
+	default:
+		return nil, errors.New("invalid MO file magic number")
+	}
+
+	count := order.Uint32(data[8:])
+	originals := order.Uint32(data[12:])
+	translations := order.Uint32(data[16:])
+	readString := func(table, i uint32) (string, error) {
+		offset := uint64(table) + uint64(i)*8
+		if offset+8 > uint64(len(data)) {
+			return "", errors.New("invalid MO string table")
+		}
+		length := uint64(order.Uint32(data[offset:]))
+		start := uint64(order.Uint32(data[offset+4:]))
+		if start+length > uint64(len(data)) {
+			return "", errors.New("invalid MO string offset")
+		}
+		return string(data[start : start+length]), nil
+	}
+
+	file := &gettextCatalog{headers: make(map[string]string)}
+	for i := uint32(0); i < count; i++ {
+		original, err := readString(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		translation, err := readString(translations, i)
+		if err != nil {
+			return nil, err
+		}
+		if original == "" {
+			file.parseHeaders(translation)
+			continue
+		}
+
+		var entry gettextEntry
+		if context, id, ok := strings.Cut(original, "\x04"); ok {
+			entry.context, original = context, id
+		}
+		entry.id, entry.idPlural, _ = strings.Cut(original, "\x00")
+		entry.strs = strings.Split(translation, "\x00")
+		file.entries = append(file.entries, entry)
+	}
+	return file, nil
+}
+
+func (c *gettextCatalog) parseHeaders(header string) {
+	for _, line := range strings.Split(header, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if ok {
+			c.headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+}
+
+// parsePluralExpr compiles the C expression of a Plural-Forms header, e.g. "(n != 1)".
+func parsePluralExpr(expr string) (func(n int) int, error) {
+	p := &pluralExprParser{src: expr}
+	eval, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.src[p.pos:], p.pos)
+	}
+	return eval, nil
+}
+
+type pluralExprParser struct {
+	src string
+	pos int
+}
+
+var pluralExprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *pluralExprParser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *pluralExprParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.src[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *pluralExprParser) ternary() (func(int) int, error) {
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume("?") {
+		return cond, nil
+	}
+	then, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume(":") {
+		return nil, fmt.Errorf("expected ':' at offset %d", p.pos)
+	}
+	otherwise, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	return func(n int) int {
+		if cond(n) != 0 {
+			return then(n)
+		}
+		return otherwise(n)
+	}, nil
+}
+
+func (p *pluralExprParser) binary(level int) (func(int) int, error) {
+	if level == len(pluralExprPrecedence) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var operator string
+		for _, op := range pluralExprPrecedence[level] {
+			if p.consume(op) {
+				operator = op
+				break
+			}
+		}
+		if operator == "" {
+			return left, nil
+		}
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = pluralExprOperator(operator, left, right)
+	}
+}
+
+func (p *pluralExprParser) unary() (func(int) int, error) {
+	if p.consume("!") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int) int { return boolToInt(operand(n) == 0) }, nil
+	}
+	if p.consume("(") {
+		inner, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, fmt.Errorf("expected ')' at offset %d", p.pos)
+		}
+		return inner, nil
+	}
+	if p.consume("n") {
+		return func(n int) int { return n }, nil
+	}
+
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, fmt.Errorf("unexpected token at offset %d", p.pos)
+	}
+	value, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		return nil, err
+	}
+	return func(int) int { return value }, nil
+}
+
+func pluralExprOperator(operator string, left, right func(int) int) func(int) int {
+	switch operator {
+	case "||":
+		return func(n int) int { return boolToInt(left(n) != 0 || right(n) != 0) }
+	case "&&":
+		return func(n int) int { return boolToInt(left(n) != 0 && right(n) != 0) }
+	case "==":
+		return func(n int) int { return boolToInt(left(n) == right(n)) }
+	case "!=":
+		return func(n int) int { return boolToInt(left(n) != right(n)) }
+	case "<=":
+		return func(n int) int { return boolToInt(left(n) <= right(n)) }
+	case ">=":
+		return func(n int) int { return boolToInt(left(n) >= right(n)) }
+	case "<":
+		return func(n int) int { return boolToInt(left(n) < right(n)) }
+	case ">":
+		return func(n int) int { return boolToInt(left(n) > right(n)) }
+	case "+":
+		return func(n int) int { return left(n) + right(n) }
+	case "-":
+		return func(n int) int { return left(n) - right(n) }
+	case "*":
+		return func(n int) int { return left(n) * right(n) }
+	case "/":
+		return func(n int) int {
+			if r := right(n); r != 0 {
+				return left(n) / r
+			}
+			return 0
+		}
+	default:
+		return func(n int) int {
+			if r := right(n); r != 0 {
+				return left(n) % r
+			}
+			return 0
+		}
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,148 @@
+package i18n_test
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestGettext(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithGettextFile("testdata/gettext/en.po", "testdata/gettext/ru.po"),
+		i18n.WithGettextFSFile(os.DirFS("testdata/gettext"), "id.mo"),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		expectedMessage string
+	}{
+		{
+			name:            "po message",
+			messageID:       "greeting",
+			options:         []any{i18n.Param("name", "John")},
+			expectedMessage: "Hello, John",
+		},
+		{
+			name:            "po message with context",
+			messageID:       "menu.open",
+			expectedMessage: "Open",
+		},
+		{
+			name:            "po message with another context",
+			messageID:       "door.open",
+			expectedMessage: "Open the door",
+		},
+		{
+			name:            "po plural one",
+			messageID:       "items",
+			options:         []any{i18n.Count(1)},
+			expectedMessage: "1 item",
+		},
+		{
+			name:            "po plural other",
+			messageID:       "items",
+			options:         []any{i18n.Count(5)},
+			expectedMessage: "5 items",
+		},
+		{
+			name:            "po multiline message",
+			messageID:       "multiline",
+			expectedMessage: "First line\nSecond line",
+		},
+		{
+			name:            "po fuzzy message is skipped",
+			messageID:       "fuzzy",
+			expectedMessage: "ERROR: missing translation for \"fuzzy\"",
+		},
+		{
+			name:            "po untranslated message is skipped",
+			messageID:       "untranslated",
+			expectedMessage: "ERROR: missing translation for \"untranslated\"",
+		},
+		{
+			name:            "po plural few",
+			messageID:       "files",
+			options:         []any{i18n.Lang("ru"), i18n.Count(3)},
+			expectedMessage: "3 файла",
+		},
+		{
+			name:            "po plural many",
+			messageID:       "files",
+			options:         []any{i18n.Lang("ru"), i18n.Count(11)},
+			expectedMessage: "11 файлов",
+		},
+		{
+			name:            "po plural one with complex rule",
+			messageID:       "files",
+			options:         []any{i18n.Lang("ru"), i18n.Count(21)},
+			expectedMessage: "21 файл",
+		},
+		{
+			name:            "mo message",
+			messageID:       "greeting",
+			options:         []any{i18n.Lang("id"), i18n.Param("name", "John")},
+			expectedMessage: "Halo, John",
+		},
+		{
+			name:            "mo message with context",
+			messageID:       "menu.open",
+			options:         []any{i18n.Lang("id")},
+			expectedMessage: "Buka",
+		},
+		{
+			name:            "mo plural",
+			messageID:       "items",
+			options:         []any{i18n.Lang("id"), i18n.Count(1)},
+			expectedMessage: "1 barang",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			message := i18n.T(tc.messageID, tc.options...)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestGettextInit(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	t.Run("when gettext file not found", func(t *testing.T) {
+		err := i18n.Init(language.English, i18n.WithGettextFile("testdata/gettext/es.po"))
+		assert.Error(t, err)
+	})
+	t.Run("when gettext file extension is not supported", func(t *testing.T) {
+		err := i18n.Init(language.English, i18n.WithGettextFile("testdata/en.yaml"))
+		assert.Error(t, err)
+	})
+	t.Run("when mo file is invalid", func(t *testing.T) {
+		fsys := fstest.MapFS{"id.mo": {Data: []byte("not a mo file")}}
+		err := i18n.Init(language.English, i18n.WithGettextFSFile(fsys, "id.mo"))
+		assert.Error(t, err)
+	})
+	t.Run("when po file is invalid", func(t *testing.T) {
+		fsys := fstest.MapFS{"id.po": {Data: []byte("msgid \"hello\"\nmsgstr[1] \"halo\"\n")}}
+		err := i18n.Init(language.English, i18n.WithGettextFSFile(fsys, "id.po"))
+		assert.Error(t, err)
+	})
+	t.Run("when po file language is unknown", func(t *testing.T) {
+		fsys := fstest.MapFS{"messages.po": {Data: []byte("msgid \"hello\"\nmsgstr \"halo\"\n")}}
+		err := i18n.Init(language.English, i18n.WithGettextFSFile(fsys, "messages.po"))
+		assert.Error(t, err)
+	})
+	t.Run("when po file language is in the directory name", func(t *testing.T) {
+		fsys := fstest.MapFS{"id/LC_MESSAGES/messages.po": {Data: []byte("msgid \"hello\"\nmsgstr \"halo\"\n")}}
+		err := i18n.Init(language.English, i18n.WithGettextFSFile(fsys, "id/LC_MESSAGES/messages.po"))
+		require.NoError(t, err)
+		assert.Equal(t, "halo", i18n.T("hello", i18n.Lang("id")))
+	})
+}
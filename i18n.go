@@ -2,8 +2,12 @@ package i18n
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"slices"
+	"sync/atomic"
+	"time"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -46,30 +50,63 @@ func Init(language language.Tag, opts ...Option) error {
 	opts = append(defaultOpts, opts...)
 	config := newI18nConfig(opts...)
 
+	reload := bundle != nil
 	defaultLanguage = language
+	logger = config.logger
 	missingTranslationHandler = config.missingTranslationHandler
 	extractLanguageFunc = config.extractLanguageFunc
+	missingTranslationReporter = config.missingTranslationReporter
+	lookupObserver = config.lookupObserver
+	namespaceFallback = config.namespaceFallback
+	messageSyntax = config.messageSyntax
+	convertMeasurement = config.convertMeasurement
 
 	bundle = i18n.NewBundle(language)
+	// Publish the matcher once the files are loaded, even if loading one of them fails.
+	defer func() { languageMatcher.Store(newLoadedLanguages(bundle.LanguageTags())) }()
+	fileConfig = config
+	localizers = newLocalizerCache(config.localizerCacheSize)
+	pseudoBundle, pseudoExpansion = nil, config.pseudoExpansion
+	if config.pseudoLocalization {
+		pseudoBundle = i18n.NewBundle(language)
+	}
+	catalog = nil
+	compiledTemplates = nil
+	localeFallbacks.Clear()
 	for format, unmarshalFunc := range config.unmarshalFuncMap {
 		bundle.RegisterUnmarshalFunc(format, unmarshalFunc)
 	}
 
 	for _, path := range config.translationFiles {
-		_, err := bundle.LoadMessageFile(path)
-		if err != nil {
+		if err := loadMessageFile(nil, path, "", config); err != nil {
 			return err
 		}
 	}
 	for _, translationFSFile := range config.translationFSFiles {
 		for _, path := range translationFSFile.paths {
-			_, err := bundle.LoadMessageFileFS(translationFSFile.fs, path)
-			if err != nil {
+			if err := loadMessageFile(translationFSFile.fs, path, translationFSFile.namespace, config); err != nil {
 				return err
 			}
 		}
 	}
+	for _, gettextFile := range config.gettextFiles {
+		for _, path := range gettextFile.paths {
+			if err := loadGettextFile(gettextFile.fs, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if config.precompileTemplates {
+		if err := precompileTemplates(); err != nil {
+			return err
+		}
+	}
 
+	if reload {
+		logAttrs(context.Background(), slog.LevelInfo, "i18n: reloaded messages",
+			slog.Any("languages", bundle.LanguageTags()))
+	}
 	return nil
 }
 
@@ -96,30 +133,95 @@ func GetCtx(ctx context.Context, id string, opts ...any) string {
 	if bundle == nil {
 		return "ERROR: i18n is not initialized"
 	}
+	return localize(ctx, extractLanguageFunc(ctx), id, opts...)
+}
+
+// localize localizes the message in the language extracted from the context and observes the lookup.
+func localize(ctx context.Context, lang, id string, opts ...any) string {
+	if lookupObserver == nil {
+		message, _ := lookup(ctx, lang, id, opts...)
+		return message
+	}
 
+	start := time.Now()
+	message, event := lookup(ctx, lang, id, opts...)
+	event.Duration = time.Since(start)
+	lookupObserver.ObserveLookup(ctx, event)
+	return message
+}
+
+// lookup localizes the message and describes the lookup.
+func lookup(ctx context.Context, lang, id string, opts ...any) (string, LookupEvent) {
 	cfg := newLocalizeConfig(opts...)
-	localizeConfig := cfg.toI18nLocalizeConfig(id)
+	localizer := localizers.get(requestedLanguages(cfg, lang))
+	languages := localizer.languages
 
-	var languages []string
-	if cfg.language != "" {
-		languages = append(languages, cfg.language)
+	if cfg.namespace != "" {
+		id = resolveNamespacedID(languages, cfg.namespace, id)
 	}
-	lang := extractLanguageFunc(ctx)
-	if lang != "" && !slices.Contains(languages, lang) {
-		languages = append(languages, lang)
+	if len(cfg.selections) > 0 {
+		id = resolveVariantID(languages, id, cfg.selections)
 	}
-	if !slices.Contains(languages, defaultLanguage.String()) {
-		languages = append(languages, defaultLanguage.String())
+	event := LookupEvent{ID: id, Languages: localizer.requested, Matched: localizer.requestedTag}
+
+	if tag, ok := pseudoLocale(localizer.tags); ok {
+		message, err := localizePseudo(tag, id, cfg)
+		event.Matched = tag
+		if message == "" {
+			event.Missing = true
+			reportMissingTranslation(ctx, event)
+			return missingTranslationHandler(id, err), event
+		}
+		event.Language = tag
+		return message, event
 	}
 
-	localizer := i18n.NewLocalizer(bundle, languages...)
-	message, err := localizer.Localize(localizeConfig)
+	var message string
+	var tag language.Tag
+	var err error
+	if tenant := GetTenant(ctx); tenant != "" {
+		message, tag = localizeTenant(ctx, tenant, localizer, id, cfg)
+	}
+	if message == "" {
+		message, tag = localizeRuntime(ctx, localizer, id, cfg)
+	}
+	if message == "" {
+		localizeConfig := cfg.toI18nLocalizeConfig(id)
+		localizeConfig.TemplateParser = localizer.parserOf(localizer.messageLanguage(id))
+		message, tag, err = localizer.localizer.LocalizeWithTag(localizeConfig)
+	}
 
+	if err != nil && !errors.As(err, new(*i18n.MessageNotFoundErr)) {
+		logAttrs(ctx, slog.LevelError, "i18n: failed to execute message template",
+			slog.String("id", id), slog.Any("languages", event.Languages), slog.Any("error", err))
+	}
 	if message == "" {
-		return missingTranslationHandler(id, err)
+		event.Missing = true
+		logAttrs(ctx, slog.LevelWarn, "i18n: missing translation", slog.String("id", id), slog.Any("languages", event.Languages))
+		reportMissingTranslation(ctx, event)
+		return missingTranslationHandler(id, err), event
+	}
+	event.Language = tag
+	if len(event.Languages) > 0 && (tag != localizer.requestedTag || localizer.unmatched) {
+		event.Fallback = true
+		logAttrs(ctx, slog.LevelDebug, "i18n: translation served in fallback language",
+			slog.String("id", id), slog.Any("languages", event.Languages), slog.String("fallback", tag.String()))
+		reportMissingTranslation(ctx, event)
 	}
 
-	return message
+	return message, event
+}
+
+// requestedLanguages returns the languages requested with the Lang option and from the context, in order.
+func requestedLanguages(cfg *localizeConfig, lang string) []string {
+	var requested []string
+	if cfg.language != "" {
+		requested = append(requested, cfg.language)
+	}
+	if lang != "" && !slices.Contains(requested, lang) {
+		requested = append(requested, lang)
+	}
+	return requested
 }
 
 // T is an alias for Get.
@@ -139,3 +241,65 @@ func T(id string, opts ...any) string {
 func TCtx(ctx context.Context, id string, opts ...any) string {
 	return GetCtx(ctx, id, opts...)
 }
+
+// loadedLanguages matches languages against the languages loaded by Init.
+// It is never modified once built, so lookups can use it without locking.
+type loadedLanguages struct {
+	matcher language.Matcher
+	tags    []language.Tag
+}
+
+func newLoadedLanguages(tags []language.Tag) *loadedLanguages {
+	tags = slices.Clone(tags)
+	return &loadedLanguages{matcher: language.NewMatcher(tags), tags: tags}
+}
+
+// languageMatcher is built once all the files are loaded and published at the end of Init,
+// so lookups don't build a matcher and never see one that is half updated.
+var languageMatcher atomic.Pointer[loadedLanguages]
+
+// matchLanguage returns the loaded language that best matches the preferred languages,
+// the same way the bundle does when localizing a message.
+func matchLanguage(preferred ...language.Tag) language.Tag {
+	tag, _ := matchLanguageConfidence(preferred...)
+	return tag
+}
+
+// matchLanguageConfidence returns the loaded language that best matches the preferred languages
+// and the confidence of the match, No if none of them matches and the default language is returned.
+func matchLanguageConfidence(preferred ...language.Tag) (language.Tag, language.Confidence) {
+	languages := languageMatcher.Load()
+	_, i, confidence := languages.matcher.Match(preferred...)
+	return languages.tags[i], confidence
+}
+
+// messageLanguage returns the language the message is localized in, the best match of the languages
+// if it has the message, otherwise the default language.
+func messageLanguage(languages []string, id string) language.Tag {
+	return messageLanguageOf(matchLanguage(parseLanguages(languages)...), id)
+}
+
+// messageLanguage returns the language the message is localized in for the cached languages.
+func (c *cachedLocalizer) messageLanguage(id string) language.Tag {
+	return messageLanguageOf(c.tag, id)
+}
+
+func messageLanguageOf(tag language.Tag, id string) language.Tag {
+	if !hasMessage(tag, id) {
+		return defaultLanguage
+	}
+	return tag
+}
+
+// parseLanguages parses the language preference list used for a lookup.
+func parseLanguages(languages []string) []language.Tag {
+	var tags []language.Tag
+	for _, lang := range languages {
+		parsed, _, err := language.ParseAcceptLanguage(lang)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, parsed...)
+	}
+	return tags
+}
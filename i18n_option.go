@@ -3,21 +3,37 @@ package i18n
 import (
 	"context"
 	"embed"
+	"io/fs"
+	"log/slog"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 )
 
 type translationFSFile struct {
-	fs    embed.FS
-	paths []string
+	fs        fs.FS
+	paths     []string
+	namespace string
 }
 
 type config struct {
-	unmarshalFuncMap          map[string]i18n.UnmarshalFunc
-	translationFiles          []string
-	translationFSFiles        []translationFSFile
-	extractLanguageFunc       func(ctx context.Context) string
-	missingTranslationHandler func(id string, err error) string
+	unmarshalFuncMap           map[string]i18n.UnmarshalFunc
+	translationFiles           []string
+	translationFSFiles         []translationFSFile
+	gettextFiles               []gettextFSFile
+	nestedKeySeparator         string
+	messageSyntax              MessageSyntax
+	convertMeasurement         bool
+	pseudoLocalization         bool
+	pseudoExpansion            int
+	fileSyntax                 map[string]MessageSyntax
+	namespaceFallback          string
+	extractLanguageFunc        func(ctx context.Context) string
+	missingTranslationHandler  func(id string, err error) string
+	missingTranslationReporter MissingTranslationReporter
+	lookupObserver             LookupObserver
+	logger                     *slog.Logger
+	localizerCacheSize         int
+	precompileTemplates        bool
 }
 
 // Option is the option for the i18n package.
@@ -25,7 +41,9 @@ type Option func(*config)
 
 func newI18nConfig(opts ...Option) *config {
 	c := &config{
-		unmarshalFuncMap: make(map[string]i18n.UnmarshalFunc),
+		unmarshalFuncMap:   make(map[string]i18n.UnmarshalFunc),
+		fileSyntax:         make(map[string]MessageSyntax),
+		localizerCacheSize: defaultLocalizerCacheSize,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -58,6 +76,129 @@ func WithTranslationFSFile(fs embed.FS, paths ...string) Option {
 	}
 }
 
+// WithNamespaceFile sets the message file paths for the namespace.
+//
+// Messages of the files are only available with the Namespace option or a Translator of the namespace,
+// so keys of different domains don't collide.
+//
+// Example:
+//
+//	i18n.WithNamespaceFile("billing", "locales/billing/en.yaml", "locales/billing/id.yaml")
+func WithNamespaceFile(namespace string, paths ...string) Option {
+	return func(c *config) {
+		c.translationFSFiles = append(c.translationFSFiles, translationFSFile{paths: paths, namespace: namespace})
+	}
+}
+
+// WithNamespaceFSFile sets the message file paths for the namespace.
+//
+// It is similar to WithNamespaceFile, but it uses embed.FS as file system.
+func WithNamespaceFSFile(namespace string, fs embed.FS, paths ...string) Option {
+	return func(c *config) {
+		c.translationFSFiles = append(c.translationFSFiles, translationFSFile{fs: fs, paths: paths, namespace: namespace})
+	}
+}
+
+// WithNamespaceFallback sets the namespace used when a message is not found in the requested namespace.
+//
+// It is used to share common messages, e.g. "common", between namespaces.
+func WithNamespaceFallback(namespace string) Option {
+	return func(c *config) {
+		c.namespaceFallback = namespace
+	}
+}
+
+// WithNestedKeys enables hierarchical message files.
+//
+// Nested maps are flattened into message IDs joined by separator, so
+// auth: { login: { title: Login } } is available as "auth.login.title" with separator ".".
+// A map is a message only if all of its keys are message fields with string values
+// (zero, one, two, few, many, other, description, ...), any other map is a level of the hierarchy.
+// An empty separator defaults to ".".
+//
+// It applies to the files set with WithTranslationFile and WithTranslationFSFile.
+func WithNestedKeys(separator string) Option {
+	return func(c *config) {
+		if separator == "" {
+			separator = "."
+		}
+		c.nestedKeySeparator = separator
+	}
+}
+
+// WithGettextFile sets the GNU gettext PO or MO file paths for the bundle.
+//
+// The language is read from the Language header, or from the file name (id.po, messages.id.mo)
+// if the header is not set. msgctxt is prepended to the message ID as a key prefix ("menu.Open"),
+// and msgstr[n] is mapped to the CLDR plural categories using the Plural-Forms header.
+// Fuzzy and untranslated entries are skipped.
+func WithGettextFile(paths ...string) Option {
+	return func(c *config) {
+		c.gettextFiles = append(c.gettextFiles, gettextFSFile{paths: paths})
+	}
+}
+
+// WithGettextFSFile sets the GNU gettext PO or MO file paths for the bundle.
+//
+// It is similar to WithGettextFile, but it reads the files from fsys.
+func WithGettextFSFile(fsys fs.FS, paths ...string) Option {
+	return func(c *config) {
+		c.gettextFiles = append(c.gettextFiles, gettextFSFile{fs: fsys, paths: paths})
+	}
+}
+
+// WithMessageSyntax sets the syntax of message bodies.
+//
+// Without paths it applies to all message files and default messages, with paths only to those files.
+// Paths are matched as passed to the file options. ICU messages use the same Params and Count as template messages.
+//
+// Example:
+//
+//	i18n.WithMessageSyntax(i18n.ICUSyntax, "locales/ar.yaml")
+func WithMessageSyntax(syntax MessageSyntax, paths ...string) Option {
+	return func(c *config) {
+		if len(paths) == 0 {
+			c.messageSyntax = syntax
+			return
+		}
+		for _, path := range paths {
+			c.fileSyntax[path] = syntax
+		}
+	}
+}
+
+// syntaxOf returns the message syntax of the file at path.
+func (c *config) syntaxOf(path string) MessageSyntax {
+	if syntax, ok := c.fileSyntax[path]; ok {
+		return syntax
+	}
+	return c.messageSyntax
+}
+
+// WithMeasurementConversion converts units to the measurement system of the language before formatting,
+// e.g. kilometers to miles for en-US and pounds to kilograms for id.
+func WithMeasurementConversion() Option {
+	return func(c *config) {
+		c.convertMeasurement = true
+	}
+}
+
+// WithPseudoLocalization enables the pseudo-locales PseudoAccented (en-XA) and PseudoBidi (ar-XB).
+//
+// Lookups for a pseudo-locale return the default language message transformed on the fly,
+// with placeholders intact and padded by expansion percent of its letters, so untranslated
+// and truncated strings stand out. Enable it for development and QA only.
+//
+// Example:
+//
+//	i18n.WithPseudoLocalization(30)
+func WithPseudoLocalization(expansion int) Option {
+	return func(c *config) {
+		c.pseudoLocalization = true
+		c.pseudoExpansion = expansion
+	}
+}
+
 // WithMissingTranslationHandler sets the missing translation handler for the bundle.
 //
 // It is used to handle the missing translation. The default handler returns the message ID.
@@ -67,6 +208,55 @@ func WithMissingTranslationHandler(missingTranslationHandler func(id string, err
 	}
 }
 
+// WithMissingTranslationReporter sets the reporter of missing translations.
+//
+// Unlike the missing translation handler, it is also called when a message is served in a fallback language,
+// and receives the requested languages, the caller and the context of the lookup.
+func WithMissingTranslationReporter(reporter MissingTranslationReporter) Option {
+	return func(c *config) {
+		c.missingTranslationReporter = reporter
+	}
+}
+
+// WithLookupObserver sets the observer of message lookups, e.g. to record metrics and traces.
+func WithLookupObserver(observer LookupObserver) Option {
+	return func(c *config) {
+		c.lookupObserver = observer
+	}
+}
+
+// WithLogger sets the logger for load warnings, missing translations, template errors and reloads.
+//
+// Loaded files and fallbacks are logged at debug level, reloads at info level, overridden messages,
+// skipped entries and missing translations at warn level and template errors at error level.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
+
+// WithLocalizerCacheSize sets the number of language preference lists localizers are cached for, 256 by default.
+//
+// Lookups reuse the localizer of their language preference list instead of parsing and matching
+// the languages every time. The least recently used localizer is evicted when the cache is full,
+// and a size of 0 disables the cache.
+func WithLocalizerCacheSize(size int) Option {
+	return func(c *config) {
+		c.localizerCacheSize = size
+	}
+}
+
+// WithPrecompiledTemplates parses the templates of all messages in all languages during Init,
+// instead of when a message is first used.
+//
+// Init fails with a *PrecompileError listing the file, language, ID and parse error of every broken template,
+// and the parsed templates are kept for rendering.
+func WithPrecompiledTemplates() Option {
+	return func(c *config) {
+		c.precompileTemplates = true
+	}
+}
+
 // WithExtractLanguageFunc sets the language extract function for the middleware.
 //
 // It is used to extract the language from the context.
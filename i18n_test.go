@@ -12,6 +12,18 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// initI18n initializes the package with the messages of testdata/en.yaml and testdata/id.yaml
+// and the options of the test, and resets it when the test ends.
+func initI18n(tb testing.TB, opts ...i18n.Option) {
+	tb.Helper()
+	tb.Cleanup(i18n.Reset)
+	opts = append([]i18n.Option{
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/en.yaml", "testdata/id.yaml"),
+	}, opts...)
+	require.NoError(tb, i18n.Init(language.English, opts...))
+}
+
 func TestT(t *testing.T) {
 	t.Run("not initialized", func(t *testing.T) {
 		msg := i18n.T("test")
@@ -58,6 +70,12 @@ func TestT(t *testing.T) {
 			options:         []any{i18n.Default("This is default message")},
 			expectedMessage: "This is default message",
 		},
+		{
+			name:            "with count",
+			messageID:       "items",
+			options:         []any{i18n.Default("{{.Count}} items"), i18n.Count(3)},
+			expectedMessage: "3 items",
+		},
 		{
 			name:            "not found and use default language",
 			messageID:       "hello_english",
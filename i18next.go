@@ -0,0 +1,169 @@
+package i18n
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// i18nextVariablePattern matches simple template variables such as {{.name}}.
+var i18nextVariablePattern = regexp.MustCompile(`\{\{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// ExportI18Next writes the messages of the language as i18next-compatible nested JSON.
+//
+// Dotted message IDs become nested objects, plural forms become "_one", "_other", ... suffixed keys,
+// and template variables such as {{.name}} are converted to i18next interpolation ({{name}}, {{count}} for Count).
+// Messages missing in the language fall back to the default language.
+// Plural messages only get the suffixes of the CLDR plural categories of the language, so Indonesian
+// only gets "_other" keys, even for messages falling back to the default language.
+// Only message IDs starting with prefix are exported, an empty prefix exports all messages.
+//
+// Example:
+//
+//	err := i18n.ExportI18Next(w, language.Indonesian, "settings.")
+func ExportI18Next(w io.Writer, tag language.Tag, prefix string) error {
+	data, err := i18nextJSON(tag, prefix)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// NewI18NextHandler creates an HTTP handler that serves the messages as i18next-compatible nested JSON.
+//
+// The language is taken from the "lang" query parameter, or from the context set by NewMiddleware,
+// and the "prefix" query parameter filters the message IDs. Responses carry an ETag and
+// requests with a matching If-None-Match header get 304 Not Modified, so browsers can cache the bundle.
+//
+// Example:
+//
+//	mux.Handle("/locales", i18n.NewMiddleware()(i18n.NewI18NextHandler()))
+func NewI18NextHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := contextLanguage(r.Context())
+		if tags := parseLanguages([]string{r.URL.Query().Get("lang")}); len(tags) > 0 {
+			requested = tags[0]
+		}
+		tag := matchLanguage(requested)
+
+		data, err := i18nextJSON(tag, r.URL.Query().Get("prefix"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sum := sha256.Sum256(data)
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+		header := w.Header()
+		header.Set("ETag", etag)
+		header.Set("Cache-Control", "no-cache")
+		header.Set("Content-Language", tag.String())
+		header.Add("Vary", "Accept-Language")
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		header.Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write(data)
+	})
+}
+
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func i18nextJSON(tag language.Tag, prefix string) ([]byte, error) {
+	if bundle == nil {
+		return nil, errors.New("i18n is not initialized")
+	}
+
+	ids := catalogIDs(defaultLanguage)
+	for _, id := range catalogIDs(tag) {
+		if _, ok := catalog[defaultLanguage][id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	// i18next only looks up the suffixes of the plural categories of the language.
+	forms := pluralForms(tag)
+	root := make(map[string]any)
+	for _, id := range ids {
+		if !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		var message, defaultMessage *i18n.Message
+		if entry, ok := catalog[defaultLanguage][id]; ok {
+			message, defaultMessage = entry.message, entry.message
+		}
+		if entry, ok := catalog[tag][id]; ok {
+			message = entry.message
+		}
+		if !isPluralMessage(message) && (defaultMessage == nil || !isPluralMessage(defaultMessage)) {
+			setI18NextValue(root, id, i18nextInterpolation(message.Other))
+			continue
+		}
+		for _, form := range forms {
+			if text := getPluralForm(message, form); text != "" {
+				setI18NextValue(root, id+"_"+pluralFormNames[form], i18nextInterpolation(text))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setI18NextValue sets the value at the dotted key, creating nested objects on the way.
+// If a part of the key is already used by a string, the rest of the key is kept flat.
+func setI18NextValue(object map[string]any, key, value string) {
+	for {
+		head, rest, ok := strings.Cut(key, ".")
+		if !ok {
+			object[key] = value
+			return
+		}
+		child, exists := object[head]
+		if !exists {
+			child = make(map[string]any)
+			object[head] = child
+		}
+		childObject, isObject := child.(map[string]any)
+		if !isObject {
+			object[key] = value
+			return
+		}
+		object, key = childObject, rest
+	}
+}
+
+func i18nextInterpolation(text string) string {
+	return i18nextVariablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := i18nextVariablePattern.FindStringSubmatch(match)[1]
+		if name == "Count" {
+			name = "count"
+		}
+		return "{{" + name + "}}"
+	})
+}
@@ -0,0 +1,143 @@
+package i18n_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExportI18Next(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name     string
+		tag      language.Tag
+		prefix   string
+		expected string
+	}{
+		{
+			name:   "default language",
+			tag:    language.English,
+			prefix: "account.",
+			expected: `{
+  "account": {
+    "files_one": "{{count}} file",
+    "files_other": "{{count}} files",
+    "greeting": "Hello, {{name}}",
+    "items_one": "{{count}} item",
+    "items_other": "{{count}} items",
+    "title": "Account"
+  }
+}
+`,
+		},
+		{
+			name:   "fallback to default language",
+			tag:    language.Indonesian,
+			prefix: "account.",
+			expected: `{
+  "account": {
+    "files_other": "{{count}} files",
+    "greeting": "Hello, {{name}}",
+    "items_other": "{{count}} barang",
+    "title": "Akun"
+  }
+}
+`,
+		},
+		{
+			name:   "with prefix",
+			tag:    language.English,
+			prefix: "account.items",
+			expected: `{
+  "account": {
+    "items_one": "{{count}} item",
+    "items_other": "{{count}} items"
+  }
+}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := i18n.ExportI18Next(&buf, tc.tag, tc.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestI18NextHandler(t *testing.T) {
+	initI18n(t)
+
+	handler := i18n.NewMiddleware()(i18n.NewI18NextHandler())
+
+	t.Run("language from middleware", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/locales?prefix=account.title", nil)
+		req.Header.Set("Accept-Language", "id-ID")
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "id", resp.Header().Get("Content-Language"))
+		assert.Equal(t, "application/json; charset=utf-8", resp.Header().Get("Content-Type"))
+		assert.NotEmpty(t, resp.Header().Get("ETag"))
+		assert.JSONEq(t, `{"account": {"title": "Akun"}}`, resp.Body.String())
+	})
+
+	t.Run("language from query", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/locales?lang=en&prefix=logout", nil)
+		req.Header.Set("Accept-Language", "id-ID")
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"logout": "Log out"}`, resp.Body.String())
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/locales?lang=id", nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		etag := resp.Header().Get("ETag")
+
+		req = httptest.NewRequest("GET", "/locales?lang=id", nil)
+		req.Header.Set("If-None-Match", etag)
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusNotModified, resp.Code)
+		assert.Empty(t, resp.Body.String())
+
+		req = httptest.NewRequest("GET", "/locales?lang=en", nil)
+		req.Header.Set("If-None-Match", etag)
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+
+	t.Run("language from extract language func", func(t *testing.T) {
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithTranslationFile("testdata/en.yaml", "testdata/id.yaml"),
+			i18n.WithExtractLanguageFunc(func(context.Context) string { return "id" }),
+		)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/locales?prefix=account.title", nil)
+		resp := httptest.NewRecorder()
+		i18n.NewI18NextHandler().ServeHTTP(resp, req)
+
+		assert.Equal(t, "id", resp.Header().Get("Content-Language"))
+		assert.JSONEq(t, `{"account": {"title": "Akun"}}`, resp.Body.String())
+	})
+}
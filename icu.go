@@ -0,0 +1,569 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// MessageSyntax is the syntax of message bodies.
+type MessageSyntax int
+
+const (
+	// TemplateSyntax is the Go text/template syntax, e.g. "Hello, {{.name}}". It is the default.
+	TemplateSyntax MessageSyntax = iota
+	// ICUSyntax is the ICU MessageFormat syntax, e.g. "{count, plural, one {# item} other {# items}}".
+	ICUSyntax
+)
+
+// ICU messages are marked with single brace delimiters, so the message parser can tell them apart
+// from text/template messages of the same bundle.
+const (
+	icuLeftDelim  = "{"
+	icuRightDelim = "}"
+)
+
+// messageSyntax is the syntax of messages without an explicit file syntax, including default messages.
+var messageSyntax MessageSyntax
+
+// setMessageSyntax marks the messages as ICU messages if syntax is ICUSyntax.
+func setMessageSyntax(syntax MessageSyntax, messages ...*i18n.Message) {
+	if syntax != ICUSyntax {
+		return
+	}
+	for _, message := range messages {
+		message.LeftDelim, message.RightDelim = icuLeftDelim, icuRightDelim
+	}
+}
+
+// messageParser parses the message bodies of a language, ICU messages with the ICU parser
+// and all other messages with text/template and the template functions of the format language.
+//
+// Plural rules are those of the language of the messages, numbers, dates, lists and units are formatted
+// in the format language, the language of the lookup, which differs for messages served in a fallback language.
+type messageParser struct {
+	tag    language.Tag
+	format language.Tag
+	text   *template.TextParser
+	// parsed caches the templates parsed by a parser that isn't Cacheable.
+	parsed sync.Map
+}
+
+func newMessageParser(tag, format language.Tag) *messageParser {
+	return &messageParser{tag: tag, format: format, text: &template.TextParser{Funcs: templateFuncs(tag, format)}}
+}
+
+// messageParsers caches the message parser of each language formatting in the same language,
+// parsers don't depend on the bundle.
+var messageParsers sync.Map
+
+// messageParserOf returns the cached message parser of the language formatting in the same language.
+func messageParserOf(tag language.Tag) *messageParser {
+	if parser, ok := messageParsers.Load(tag); ok {
+		return parser.(*messageParser)
+	}
+	parser, _ := messageParsers.LoadOrStore(tag, newMessageParser(tag, tag))
+	return parser.(*messageParser)
+}
+
+// Cacheable reports whether the bundle can cache parsed templates in the messages, which keep a single
+// parsed template. A message always belongs to the same language, so templates formatting in the language
+// of the message are cached there, and templates formatting in another language in the parser.
+func (p *messageParser) Cacheable() bool {
+	return p.format == p.tag
+}
+
+func (p *messageParser) Parse(src, leftDelim, rightDelim string) (template.ParsedTemplate, error) {
+	if p.Cacheable() {
+		if parsed, ok := compiledTemplate(p.tag, src, leftDelim, rightDelim); ok {
+			return parsed, nil
+		}
+		return p.parse(src, leftDelim, rightDelim)
+	}
+
+	source := templateSource{src: src, leftDelim: leftDelim, rightDelim: rightDelim}
+	if parsed, ok := p.parsed.Load(source); ok {
+		return parsed.(template.ParsedTemplate), nil
+	}
+	parsed, err := p.parse(src, leftDelim, rightDelim)
+	if err != nil {
+		return nil, err
+	}
+	p.parsed.Store(source, parsed)
+	return parsed, nil
+}
+
+func (p *messageParser) parse(src, leftDelim, rightDelim string) (template.ParsedTemplate, error) {
+	if leftDelim == icuLeftDelim && rightDelim == icuRightDelim {
+		return parseICU(src, p.tag, p.format)
+	}
+	return p.text.Parse(src, leftDelim, rightDelim)
+}
+
+// icuMessage is a parsed ICU MessageFormat message.
+type icuMessage struct {
+	tag    language.Tag
+	format language.Tag
+	nodes  []icuNode
+}
+
+type icuNode interface {
+	format(b *strings.Builder, state *icuState) error
+}
+
+// icuState is the state of a single message execution.
+type icuState struct {
+	// tag is the language of the plural rules, format the language values are formatted in.
+	tag     language.Tag
+	format  language.Tag
+	printer *message.Printer
+	data    map[string]any
+	// pound is the value printed for # inside plural cases.
+	pound any
+}
+
+func parseICU(src string, tag, format language.Tag) (*icuMessage, error) {
+	p := &icuParser{src: []rune(src)}
+	nodes, err := p.parseMessage(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected '}'")
+	}
+	return &icuMessage{tag: tag, format: format, nodes: nodes}, nil
+}
+
+// Execute formats the message with data, which is usually the Params of the lookup.
+func (m *icuMessage) Execute(data any) (string, error) {
+	state := &icuState{tag: m.tag, format: m.format, printer: message.NewPrinter(m.format), data: icuData(data)}
+	var b strings.Builder
+	if err := formatICUNodes(&b, m.nodes, state); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func icuData(data any) map[string]any {
+	switch v := data.(type) {
+	case map[string]any:
+		return v
+	case Params:
+		return v
+	}
+	result := make(map[string]any)
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Map {
+		for _, key := range value.MapKeys() {
+			result[fmt.Sprint(key.Interface())] = value.MapIndex(key).Interface()
+		}
+	}
+	return result
+}
+
+func formatICUNodes(b *strings.Builder, nodes []icuNode, state *icuState) error {
+	for _, node := range nodes {
+		if err := node.format(b, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup returns the value of the argument. Names are matched case-insensitively if there is
+// no exact match, so {count, plural, ...} uses the Count of the lookup.
+func (s *icuState) lookup(name string) (any, bool) {
+	if value, ok := s.data[name]; ok {
+		return value, true
+	}
+	for key, value := range s.data {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// lookupSelector returns the value of an argument that is matched or formatted rather than interpolated,
+// without the bidi isolation added by IsolateParams, so it still matches the cases of select and plural.
+func (s *icuState) lookupSelector(name string) (any, bool) {
+	value, ok := s.lookup(name)
+	if text, isString := value.(string); isString && isIsolated(text) {
+		_, size := utf8.DecodeRuneInString(text)
+		return text[size : len(text)-len(popDirectionalIsolate)], true
+	}
+	return value, ok
+}
+
+type icuText string
+
+func (t icuText) format(b *strings.Builder, _ *icuState) error {
+	b.WriteString(string(t))
+	return nil
+}
+
+type icuPound struct{}
+
+func (icuPound) format(b *strings.Builder, state *icuState) error {
+	if state.pound == nil {
+		b.WriteByte('#')
+		return nil
+	}
+	b.WriteString(state.printer.Sprint(number.Decimal(state.pound)))
+	return nil
+}
+
+type icuArgument struct {
+	name  string
+	kind  string
+	style string
+}
+
+func (a *icuArgument) format(b *strings.Builder, state *icuState) error {
+	value, ok := state.lookup(a.name)
+	if !ok {
+		b.WriteString("{" + a.name + "}")
+		return nil
+	}
+	switch a.kind {
+	case "":
+		if !isNumberKind(value) {
+			b.WriteString(fmt.Sprint(value))
+			return nil
+		}
+		b.WriteString(formatNumber(state.format, value))
+		return nil
+	case "number":
+		value, _ = state.lookupSelector(a.name)
+		if !isNumber(value) {
+			return fmt.Errorf("argument %q is not a number: %#v", a.name, value)
+		}
+		switch a.style {
+		case "":
+			b.WriteString(formatNumber(state.format, value))
+		case "integer":
+			n, _ := numberValue(value)
+			b.WriteString(state.printer.Sprint(number.Decimal(n, number.MaxFractionDigits(0))))
+		case "percent":
+			b.WriteString(formatPercent(state.format, value))
+		case "currency":
+			unit, _ := currency.FromTag(state.format)
+			b.WriteString(formatCurrency(state.format, value, unit.String()))
+		default:
+			return fmt.Errorf("unsupported number style %q", a.style)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported argument type %q", a.kind)
+}
+
+type icuPlural struct {
+	name    string
+	ordinal bool
+	offset  float64
+	cases   map[string][]icuNode
+}
+
+func (p *icuPlural) format(b *strings.Builder, state *icuState) error {
+	value, ok := state.lookupSelector(p.name)
+	if !ok {
+		return fmt.Errorf("missing plural argument %q", p.name)
+	}
+	n, ok := toFloat(value)
+	if !ok {
+		return fmt.Errorf("plural argument %q is not a number: %#v", p.name, value)
+	}
+
+	nodes, ok := p.cases["="+strconv.FormatFloat(n, 'f', -1, 64)]
+	if !ok {
+		rules := plural.Cardinal
+		if p.ordinal {
+			rules = plural.Ordinal
+		}
+		nodes, ok = p.cases[pluralFormNames[matchPluralForm(rules, state.tag, n-p.offset)]]
+		if !ok {
+			nodes = p.cases["other"]
+		}
+	}
+
+	pound := state.pound
+	state.pound = n - p.offset
+	defer func() { state.pound = pound }()
+	return formatICUNodes(b, nodes, state)
+}
+
+type icuSelect struct {
+	name  string
+	cases map[string][]icuNode
+}
+
+func (s *icuSelect) format(b *strings.Builder, state *icuState) error {
+	value, _ := state.lookupSelector(s.name)
+	nodes, ok := s.cases[fmt.Sprint(value)]
+	if !ok || value == nil {
+		nodes = s.cases["other"]
+	}
+	return formatICUNodes(b, nodes, state)
+}
+
+// matchPluralForm returns the plural form of n, taking its visible fraction digits into account.
+func matchPluralForm(rules *plural.Rules, tag language.Tag, n float64) plural.Form {
+	n = math.Abs(n)
+	digits := strconv.FormatFloat(n, 'f', -1, 64)
+	integer, fraction, _ := strings.Cut(digits, ".")
+	i, err := strconv.Atoi(integer)
+	if err != nil {
+		return plural.Other
+	}
+	f, _ := strconv.Atoi(fraction)
+	t, _ := strconv.Atoi(strings.TrimRight(fraction, "0"))
+	return rules.MatchPlural(tag, i, len(fraction), len(strings.TrimRight(fraction, "0")), f, t)
+}
+
+func isNumber(value any) bool {
+	_, ok := toFloat(value)
+	return ok
+}
+
+// isNumberKind reports whether the value is an integer or a float. Strings are printed as they are
+// in simple arguments, even if they look like numbers, e.g. zero-padded IDs.
+func isNumberKind(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func toFloat(value any) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+type icuParser struct {
+	src []rune
+	pos int
+}
+
+func (p *icuParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("icu: offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *icuParser) peek() (rune, bool) {
+	if p.pos < len(p.src) {
+		return p.src[p.pos], true
+	}
+	return 0, false
+}
+
+func (p *icuParser) skipSpaces() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseMessage parses message text until the closing brace of the enclosing argument or the end of src.
+func (p *icuParser) parseMessage(inPlural bool) ([]icuNode, error) {
+	var (
+		nodes []icuNode
+		text  strings.Builder
+	)
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, icuText(text.String()))
+			text.Reset()
+		}
+	}
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		switch {
+		case r == '\'':
+			p.parseQuoted(&text, inPlural)
+		case r == '{':
+			flush()
+			node, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+		case r == '}':
+			flush()
+			return nodes, nil
+		case r == '#' && inPlural:
+			flush()
+			nodes = append(nodes, icuPound{})
+			p.pos++
+		default:
+			text.WriteRune(r)
+			p.pos++
+		}
+	}
+	flush()
+	return nodes, nil
+}
+
+// parseQuoted handles apostrophes: a doubled apostrophe is a literal apostrophe, and an apostrophe before a special
+// character starts quoted literal text up to the next single apostrophe.
+func (p *icuParser) parseQuoted(text *strings.Builder, inPlural bool) {
+	p.pos++
+	next, ok := p.peek()
+	if !ok {
+		text.WriteRune('\'')
+		return
+	}
+	if next == '\'' {
+		text.WriteRune('\'')
+		p.pos++
+		return
+	}
+	if next != '{' && next != '}' && next != '|' && (next != '#' || !inPlural) {
+		text.WriteRune('\'')
+		return
+	}
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		p.pos++
+		if r == '\'' {
+			if next, ok := p.peek(); ok && next == '\'' {
+				text.WriteRune('\'')
+				p.pos++
+				continue
+			}
+			return
+		}
+		text.WriteRune(r)
+	}
+}
+
+func (p *icuParser) parseIdentifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		if unicode.IsSpace(r) || r == ',' || r == '{' || r == '}' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+func (p *icuParser) expect(r rune) error {
+	p.skipSpaces()
+	if next, ok := p.peek(); !ok || next != r {
+		return p.errorf("expected %q", r)
+	}
+	p.pos++
+	return nil
+}
+
+// parseArgument parses an argument, inPlural reports whether it is nested in a plural case,
+// where # is the plural number also inside nested select cases.
+func (p *icuParser) parseArgument(inPlural bool) (icuNode, error) {
+	p.pos++ // {
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.errorf("expected argument name")
+	}
+	p.skipSpaces()
+	if next, ok := p.peek(); ok && next == '}' {
+		p.pos++
+		return &icuArgument{name: name}, nil
+	}
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+
+	kind := p.parseIdentifier()
+	switch kind {
+	case "plural", "selectordinal", "select":
+		if err := p.expect(','); err != nil {
+			return nil, err
+		}
+		return p.parseCases(name, kind, inPlural)
+	case "number":
+		argument := &icuArgument{name: name, kind: kind}
+		p.skipSpaces()
+		if next, ok := p.peek(); ok && next == ',' {
+			p.pos++
+			argument.style = p.parseIdentifier()
+		}
+		return argument, p.expect('}')
+	default:
+		return nil, p.errorf("unsupported argument type %q", kind)
+	}
+}
+
+func (p *icuParser) parseCases(name, kind string, inPlural bool) (icuNode, error) {
+	cases := make(map[string][]icuNode)
+	var offset float64
+	for {
+		p.skipSpaces()
+		next, ok := p.peek()
+		if !ok {
+			return nil, p.errorf("unterminated %s argument", kind)
+		}
+		if next == '}' {
+			p.pos++
+			break
+		}
+
+		selector := p.parseIdentifier()
+		if kind != "select" && strings.HasPrefix(selector, "offset:") {
+			value, err := strconv.ParseFloat(strings.TrimPrefix(selector, "offset:"), 64)
+			if err != nil {
+				return nil, p.errorf("invalid offset %q", selector)
+			}
+			offset = value
+			continue
+		}
+		if selector == "" {
+			return nil, p.errorf("expected %s selector", kind)
+		}
+		if err := p.expect('{'); err != nil {
+			return nil, err
+		}
+		nodes, err := p.parseMessage(inPlural || kind != "select")
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect('}'); err != nil {
+			return nil, err
+		}
+		cases[selector] = nodes
+	}
+	if _, ok := cases["other"]; !ok {
+		return nil, p.errorf("%s argument %q has no 'other' case", kind, name)
+	}
+
+	if kind == "select" {
+		return &icuSelect{name: name, cases: cases}, nil
+	}
+	return &icuPlural{name: name, ordinal: kind == "selectordinal", offset: offset, cases: cases}, nil
+}
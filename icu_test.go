@@ -0,0 +1,187 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestICUSyntax(t *testing.T) {
+	initI18n(t,
+		i18n.WithTranslationFile("testdata/icu.en.yaml", "testdata/icu.id.yaml"),
+		i18n.WithMessageSyntax(i18n.ICUSyntax, "testdata/icu.en.yaml", "testdata/icu.id.yaml"),
+	)
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		language        string
+		expectedMessage string
+	}{
+		{
+			name:            "argument",
+			messageID:       "greeting",
+			options:         []any{i18n.Params{"name": "John"}},
+			expectedMessage: "Hello, John!",
+		},
+		{
+			name:            "missing argument",
+			messageID:       "greeting",
+			expectedMessage: "Hello, {name}!",
+		},
+		{
+			name:            "argument with language",
+			messageID:       "greeting",
+			options:         []any{i18n.Params{"name": "John"}},
+			language:        "id",
+			expectedMessage: "Halo, John!",
+		},
+		{
+			name:            "plural with count",
+			messageID:       "cart",
+			options:         []any{i18n.Count(1)},
+			expectedMessage: "1 item",
+		},
+		{
+			name:            "plural number in nested select",
+			messageID:       "photos",
+			options:         []any{i18n.Count(3), i18n.Params{"gender": "female"}},
+			expectedMessage: "her 3 photos",
+		},
+		{
+			name:            "literal # in select",
+			messageID:       "hashtag",
+			options:         []any{i18n.Params{"topic": "go"}},
+			expectedMessage: "#go",
+		},
+		{
+			name:            "plural with grouping",
+			messageID:       "cart",
+			options:         []any{i18n.Count(1200)},
+			expectedMessage: "1,200 items",
+		},
+		{
+			name:            "plural with exact match",
+			messageID:       "cart",
+			options:         []any{i18n.Count(0)},
+			expectedMessage: "No items",
+		},
+		{
+			name:            "plural with param",
+			messageID:       "cart",
+			options:         []any{i18n.Param("count", 5)},
+			expectedMessage: "5 items",
+		},
+		{
+			name:            "plural with language",
+			messageID:       "cart",
+			options:         []any{i18n.Count(1200)},
+			language:        "id",
+			expectedMessage: "1.200 barang",
+		},
+		{
+			name:            "select",
+			messageID:       "invitation",
+			options:         []any{i18n.Param("gender", "female")},
+			expectedMessage: "She invited you",
+		},
+		{
+			name:            "select other",
+			messageID:       "invitation",
+			options:         []any{i18n.Param("gender", "unknown")},
+			expectedMessage: "They invited you",
+		},
+		{
+			name:            "plural with offset",
+			messageID:       "guests",
+			options:         []any{i18n.Params{"host": "Alice", "guests": 3}},
+			expectedMessage: "Alice invited you and 2 others",
+		},
+		{
+			name:            "plural with offset and exact match",
+			messageID:       "guests",
+			options:         []any{i18n.Params{"host": "Alice", "guests": 1}},
+			expectedMessage: "Alice invited you",
+		},
+		{
+			name:            "selectordinal",
+			messageID:       "place",
+			options:         []any{i18n.Param("place", 22)},
+			expectedMessage: "You finished 22nd",
+		},
+		{
+			name:            "string argument that looks like a number",
+			messageID:       "order",
+			options:         []any{i18n.Params{"orderId": "00123", "zip": "12345"}},
+			expectedMessage: "Order 00123 zip 12345",
+		},
+		{
+			name:            "number argument",
+			messageID:       "order",
+			options:         []any{i18n.Params{"orderId": 12345, "zip": "12345"}},
+			expectedMessage: "Order 12,345 zip 12345",
+		},
+		{
+			name:            "number",
+			messageID:       "amount",
+			options:         []any{i18n.Param("amount", 1234.5)},
+			language:        "id",
+			expectedMessage: "Total: 1.234,5",
+		},
+		{
+			name:            "percent",
+			messageID:       "ratio",
+			options:         []any{i18n.Param("ratio", 0.25)},
+			expectedMessage: "Done: 25%",
+		},
+		{
+			name:            "quoted",
+			messageID:       "quoted",
+			expectedMessage: "Use {name} for names, it's easy",
+		},
+		{
+			name:            "template file",
+			messageID:       "hello_name",
+			options:         []any{i18n.Param("name", "John")},
+			expectedMessage: "Hello, John",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expectedMessage, i18n.GetCtx(ctx, tc.messageID, tc.options...))
+		})
+	}
+}
+
+func TestICUSyntaxGlobal(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/icu.en.yaml"),
+		i18n.WithMessageSyntax(i18n.ICUSyntax),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "3 items", i18n.T("cart", i18n.Count(3)))
+	assert.Equal(t, "Hi, John", i18n.T("unknown", i18n.Default("Hi, {name}"), i18n.Param("name", "John")))
+}
+
+func TestICUSyntaxInvalid(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English, i18n.WithMessageSyntax(i18n.ICUSyntax))
+	require.NoError(t, err)
+
+	message := i18n.T("unknown", i18n.Default("{count, plural, one {# item}}"), i18n.Count(1))
+	assert.Equal(t, `ERROR: missing translation for "unknown"`, message)
+}
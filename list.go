@@ -0,0 +1,85 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ListStyle is the CLDR style of a formatted list.
+type ListStyle string
+
+const (
+	// ListAnd is a conjunction, e.g. "Alice, Bob, and Carol".
+	ListAnd ListStyle = "and"
+	// ListOr is a disjunction, e.g. "Alice, Bob, or Carol".
+	ListOr ListStyle = "or"
+)
+
+// listMessagePrefix is followed by the style and the pattern, e.g. "i18n.list.and.end": "{0}, and {1}".
+// The start, middle and end patterns join the items of lists with more than two items,
+// the two pattern joins lists of two items. The middle pattern defaults to the start pattern.
+const listMessagePrefix = "i18n.list."
+
+// FormatList joins the items with the CLDR list patterns of the style in the context language.
+//
+// Built-in patterns cover English, Indonesian, Arabic, German, Spanish, French, Japanese and Chinese,
+// and can be added or overridden with "i18n.list" messages in the locale files.
+//
+// Example:
+//
+//	i18n.FormatList(ctx, []string{"Alice", "Bob", "Carol"}, i18n.ListAnd) // Alice, Bob, and Carol in English, Alice, Bob, dan Carol in Indonesian
+func FormatList(ctx context.Context, items []string, style ListStyle) string {
+	return formatList(contextLanguage(ctx), items, style)
+}
+
+func formatList(tag language.Tag, items []string, style ListStyle) string {
+	pattern := func(name string) string {
+		text := localeText(tag, listMessagePrefix+string(style)+"."+name, 0)
+		if text == "" && name == "middle" {
+			return localeText(tag, listMessagePrefix+string(style)+".start", 0)
+		}
+		return text
+	}
+	join := func(pattern, first, second string) string {
+		if pattern == "" {
+			pattern = "{0}, {1}"
+		}
+		return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return join(pattern("two"), items[0], items[1])
+	}
+	n := len(items)
+	result := join(pattern("end"), items[n-2], items[n-1])
+	middle := pattern("middle")
+	for i := n - 3; i > 0; i-- {
+		result = join(middle, items[i], result)
+	}
+	return join(pattern("start"), items[0], result)
+}
+
+// listItems converts a slice of any type to the list items.
+func listItems(value any) []string {
+	if items, ok := value.([]string); ok {
+		return items
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return []string{fmt.Sprint(value)}
+	}
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = fmt.Sprint(v.Index(i).Interface())
+	}
+	return items
+}
@@ -0,0 +1,113 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatList(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name     string
+		language string
+		items    []string
+		style    i18n.ListStyle
+		expected string
+	}{
+		{
+			name:     "empty",
+			style:    i18n.ListAnd,
+			expected: "",
+		},
+		{
+			name:     "single item",
+			items:    []string{"Alice"},
+			style:    i18n.ListAnd,
+			expected: "Alice",
+		},
+		{
+			name:     "two items",
+			items:    []string{"Alice", "Bob"},
+			style:    i18n.ListAnd,
+			expected: "Alice and Bob",
+		},
+		{
+			name:     "oxford comma",
+			items:    []string{"Alice", "Bob", "Carol"},
+			style:    i18n.ListAnd,
+			expected: "Alice, Bob, and Carol",
+		},
+		{
+			name:     "disjunction",
+			items:    []string{"Alice", "Bob", "Carol", "Dave"},
+			style:    i18n.ListOr,
+			expected: "Alice, Bob, Carol, or Dave",
+		},
+		{
+			name:     "indonesian",
+			language: "id",
+			items:    []string{"Alice", "Bob", "Carol"},
+			style:    i18n.ListAnd,
+			expected: "Alice, Bob, dan Carol",
+		},
+		{
+			name:     "indonesian two items",
+			language: "id",
+			items:    []string{"Alice", "Bob"},
+			style:    i18n.ListAnd,
+			expected: "Alice dan Bob",
+		},
+		{
+			name:     "overridden by locale file",
+			language: "id",
+			items:    []string{"Alice", "Bob", "Carol"},
+			style:    i18n.ListOr,
+			expected: "Alice, Bob atau Carol",
+		},
+		{
+			name:     "japanese",
+			language: "ja",
+			items:    []string{"アリス", "ボブ", "キャロル"},
+			style:    i18n.ListAnd,
+			expected: "アリス、ボブ、キャロル",
+		},
+		{
+			name:     "german",
+			language: "de",
+			items:    []string{"Alice", "Bob", "Carol"},
+			style:    i18n.ListAnd,
+			expected: "Alice, Bob und Carol",
+		},
+		{
+			name:     "language without patterns uses english",
+			language: "nl",
+			items:    []string{"Alice", "Bob"},
+			style:    i18n.ListOr,
+			expected: "Alice or Bob",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expected, i18n.FormatList(ctx, tc.items, tc.style))
+		})
+	}
+}
+
+func TestListTemplateFunc(t *testing.T) {
+	initI18n(t)
+
+	names := []string{"Alice", "Bob", "Carol"}
+	assert.Equal(t, "Alice, Bob, and Carol invited you", i18n.T("invitees", i18n.Param("names", names)))
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	assert.Equal(t, "Alice, Bob, dan Carol mengundang Anda", i18n.GetCtx(ctx, "invitees", i18n.Param("names", names)))
+}
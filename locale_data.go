@@ -0,0 +1,441 @@
+package i18n
+
+import "github.com/nicksnyder/go-i18n/v2/i18n"
+
+// builtinLocaleData is the CLDR locale data used to format dates, relative times, lists and units
+// and to name languages where the names of golang.org/x/text/language/display differ,
+// keyed by base language and message ID.
+var builtinLocaleData = map[string]map[string]*i18n.Message{
+	"en": {
+		"i18n.date.full":               {Other: "EEEE, MMMM d, y"},
+		"i18n.date.long":               {Other: "MMMM d, y"},
+		"i18n.date.medium":             {Other: "MMM d, y"},
+		"i18n.date.short":              {Other: "M/d/yy"},
+		"i18n.time.full":               {Other: "h:mm:ss a zzzz"},
+		"i18n.time.long":               {Other: "h:mm:ss a z"},
+		"i18n.time.medium":             {Other: "h:mm:ss a"},
+		"i18n.time.short":              {Other: "h:mm a"},
+		"i18n.date.months.wide":        {Other: "January,February,March,April,May,June,July,August,September,October,November,December"},
+		"i18n.date.months.abbreviated": {Other: "Jan,Feb,Mar,Apr,May,Jun,Jul,Aug,Sep,Oct,Nov,Dec"},
+		"i18n.date.days.wide":          {Other: "Sunday,Monday,Tuesday,Wednesday,Thursday,Friday,Saturday"},
+		"i18n.date.days.abbreviated":   {Other: "Sun,Mon,Tue,Wed,Thu,Fri,Sat"},
+		"i18n.date.dayPeriods":         {Other: "AM,PM"},
+		"i18n.relative.now":            {Other: "now"},
+		"i18n.relative.second.past":    {One: "{{.Count}} second ago", Other: "{{.Count}} seconds ago"},
+		"i18n.relative.second.future":  {One: "in {{.Count}} second", Other: "in {{.Count}} seconds"},
+		"i18n.relative.minute.past":    {One: "{{.Count}} minute ago", Other: "{{.Count}} minutes ago"},
+		"i18n.relative.minute.future":  {One: "in {{.Count}} minute", Other: "in {{.Count}} minutes"},
+		"i18n.relative.hour.past":      {One: "{{.Count}} hour ago", Other: "{{.Count}} hours ago"},
+		"i18n.relative.hour.future":    {One: "in {{.Count}} hour", Other: "in {{.Count}} hours"},
+		"i18n.relative.day.past":       {One: "{{.Count}} day ago", Other: "{{.Count}} days ago"},
+		"i18n.relative.day.future":     {One: "in {{.Count}} day", Other: "in {{.Count}} days"},
+		"i18n.relative.week.past":      {One: "{{.Count}} week ago", Other: "{{.Count}} weeks ago"},
+		"i18n.relative.week.future":    {One: "in {{.Count}} week", Other: "in {{.Count}} weeks"},
+		"i18n.relative.month.past":     {One: "{{.Count}} month ago", Other: "{{.Count}} months ago"},
+		"i18n.relative.month.future":   {One: "in {{.Count}} month", Other: "in {{.Count}} months"},
+		"i18n.relative.year.past":      {One: "{{.Count}} year ago", Other: "{{.Count}} years ago"},
+		"i18n.relative.year.future":    {One: "in {{.Count}} year", Other: "in {{.Count}} years"},
+		"i18n.list.and.start":          {Other: "{0}, {1}"},
+		"i18n.list.and.end":            {Other: "{0}, and {1}"},
+		"i18n.list.and.two":            {Other: "{0} and {1}"},
+		"i18n.list.or.start":           {Other: "{0}, {1}"},
+		"i18n.list.or.end":             {Other: "{0}, or {1}"},
+		"i18n.list.or.two":             {Other: "{0} or {1}"},
+		"i18n.unit.gram.long":          {One: "{0} gram", Other: "{0} grams"},
+		"i18n.unit.gram.short":         {Other: "{0} g"},
+		"i18n.unit.gram.narrow":        {Other: "{0}g"},
+		"i18n.unit.kilogram.long":      {One: "{0} kilogram", Other: "{0} kilograms"},
+		"i18n.unit.kilogram.short":     {Other: "{0} kg"},
+		"i18n.unit.kilogram.narrow":    {Other: "{0}kg"},
+		"i18n.unit.ounce.long":         {One: "{0} ounce", Other: "{0} ounces"},
+		"i18n.unit.ounce.short":        {Other: "{0} oz"},
+		"i18n.unit.ounce.narrow":       {Other: "{0}oz"},
+		"i18n.unit.pound.long":         {One: "{0} pound", Other: "{0} pounds"},
+		"i18n.unit.pound.short":        {Other: "{0} lb"},
+		"i18n.unit.pound.narrow":       {Other: "{0}lb"},
+		"i18n.unit.centimeter.long":    {One: "{0} centimeter", Other: "{0} centimeters"},
+		"i18n.unit.centimeter.short":   {Other: "{0} cm"},
+		"i18n.unit.centimeter.narrow":  {Other: "{0}cm"},
+		"i18n.unit.meter.long":         {One: "{0} meter", Other: "{0} meters"},
+		"i18n.unit.meter.short":        {Other: "{0} m"},
+		"i18n.unit.meter.narrow":       {Other: "{0}m"},
+		"i18n.unit.kilometer.long":     {One: "{0} kilometer", Other: "{0} kilometers"},
+		"i18n.unit.kilometer.short":    {Other: "{0} km"},
+		"i18n.unit.kilometer.narrow":   {Other: "{0}km"},
+		"i18n.unit.inch.long":          {One: "{0} inch", Other: "{0} inches"},
+		"i18n.unit.inch.short":         {Other: "{0} in"},
+		"i18n.unit.inch.narrow":        {Other: "{0}″"},
+		"i18n.unit.foot.long":          {One: "{0} foot", Other: "{0} feet"},
+		"i18n.unit.foot.short":         {Other: "{0} ft"},
+		"i18n.unit.foot.narrow":        {Other: "{0}′"},
+		"i18n.unit.mile.long":          {One: "{0} mile", Other: "{0} miles"},
+		"i18n.unit.mile.short":         {Other: "{0} mi"},
+		"i18n.unit.mile.narrow":        {Other: "{0}mi"},
+		"i18n.unit.liter.long":         {One: "{0} liter", Other: "{0} liters"},
+		"i18n.unit.liter.short":        {Other: "{0} L"},
+		"i18n.unit.liter.narrow":       {Other: "{0}L"},
+		"i18n.unit.gallon.long":        {One: "{0} gallon", Other: "{0} gallons"},
+		"i18n.unit.gallon.short":       {Other: "{0} gal"},
+		"i18n.unit.gallon.narrow":      {Other: "{0}gal"},
+		"i18n.unit.celsius.long":       {One: "{0} degree Celsius", Other: "{0} degrees Celsius"},
+		"i18n.unit.celsius.short":      {Other: "{0}°C"},
+		"i18n.unit.celsius.narrow":     {Other: "{0}°C"},
+		"i18n.unit.fahrenheit.long":    {One: "{0} degree Fahrenheit", Other: "{0} degrees Fahrenheit"},
+		"i18n.unit.fahrenheit.short":   {Other: "{0}°F"},
+		"i18n.unit.fahrenheit.narrow":  {Other: "{0}°"},
+		"i18n.unit.second.long":        {One: "{0} second", Other: "{0} seconds"},
+		"i18n.unit.second.short":       {Other: "{0} sec"},
+		"i18n.unit.second.narrow":      {Other: "{0}s"},
+		"i18n.unit.minute.long":        {One: "{0} minute", Other: "{0} minutes"},
+		"i18n.unit.minute.short":       {Other: "{0} min"},
+		"i18n.unit.minute.narrow":      {Other: "{0}m"},
+		"i18n.unit.hour.long":          {One: "{0} hour", Other: "{0} hours"},
+		"i18n.unit.hour.short":         {Other: "{0} hr"},
+		"i18n.unit.hour.narrow":        {Other: "{0}h"},
+		"i18n.unit.day.long":           {One: "{0} day", Other: "{0} days"},
+		"i18n.unit.day.short":          {One: "{0} day", Other: "{0} days"},
+		"i18n.unit.day.narrow":         {Other: "{0}d"},
+		"i18n.unit.week.long":          {One: "{0} week", Other: "{0} weeks"},
+		"i18n.unit.week.short":         {One: "{0} wk", Other: "{0} wks"},
+		"i18n.unit.week.narrow":        {Other: "{0}w"},
+	},
+	"id": {
+		"i18n.date.full":               {Other: "EEEE, dd MMMM y"},
+		"i18n.date.long":               {Other: "d MMMM y"},
+		"i18n.date.medium":             {Other: "d MMM y"},
+		"i18n.date.short":              {Other: "dd/MM/yy"},
+		"i18n.time.full":               {Other: "HH.mm.ss zzzz"},
+		"i18n.time.long":               {Other: "HH.mm.ss z"},
+		"i18n.time.medium":             {Other: "HH.mm.ss"},
+		"i18n.time.short":              {Other: "HH.mm"},
+		"i18n.date.months.wide":        {Other: "Januari,Februari,Maret,April,Mei,Juni,Juli,Agustus,September,Oktober,November,Desember"},
+		"i18n.date.months.abbreviated": {Other: "Jan,Feb,Mar,Apr,Mei,Jun,Jul,Agu,Sep,Okt,Nov,Des"},
+		"i18n.date.days.wide":          {Other: "Minggu,Senin,Selasa,Rabu,Kamis,Jumat,Sabtu"},
+		"i18n.date.days.abbreviated":   {Other: "Min,Sen,Sel,Rab,Kam,Jum,Sab"},
+		"i18n.date.dayPeriods":         {Other: "AM,PM"},
+		"i18n.relative.now":            {Other: "sekarang"},
+		"i18n.relative.second.past":    {Other: "{{.Count}} detik yang lalu"},
+		"i18n.relative.second.future":  {Other: "dalam {{.Count}} detik"},
+		"i18n.relative.minute.past":    {Other: "{{.Count}} menit yang lalu"},
+		"i18n.relative.minute.future":  {Other: "dalam {{.Count}} menit"},
+		"i18n.relative.hour.past":      {Other: "{{.Count}} jam yang lalu"},
+		"i18n.relative.hour.future":    {Other: "dalam {{.Count}} jam"},
+		"i18n.relative.day.past":       {Other: "{{.Count}} hari yang lalu"},
+		"i18n.relative.day.future":     {Other: "dalam {{.Count}} hari"},
+		"i18n.relative.week.past":      {Other: "{{.Count}} minggu yang lalu"},
+		"i18n.relative.week.future":    {Other: "dalam {{.Count}} minggu"},
+		"i18n.relative.month.past":     {Other: "{{.Count}} bulan yang lalu"},
+		"i18n.relative.month.future":   {Other: "dalam {{.Count}} bulan"},
+		"i18n.relative.year.past":      {Other: "{{.Count}} tahun yang lalu"},
+		"i18n.relative.year.future":    {Other: "dalam {{.Count}} tahun"},
+		"i18n.list.and.start":          {Other: "{0}, {1}"},
+		"i18n.list.and.end":            {Other: "{0}, dan {1}"},
+		"i18n.list.and.two":            {Other: "{0} dan {1}"},
+		"i18n.list.or.start":           {Other: "{0}, {1}"},
+		"i18n.list.or.end":             {Other: "{0}, atau {1}"},
+		"i18n.list.or.two":             {Other: "{0} atau {1}"},
+		"i18n.unit.gram.long":          {Other: "{0} gram"},
+		"i18n.unit.gram.short":         {Other: "{0} g"},
+		"i18n.unit.gram.narrow":        {Other: "{0}g"},
+		"i18n.unit.kilogram.long":      {Other: "{0} kilogram"},
+		"i18n.unit.kilogram.short":     {Other: "{0} kg"},
+		"i18n.unit.kilogram.narrow":    {Other: "{0}kg"},
+		"i18n.unit.ounce.long":         {Other: "{0} ons"},
+		"i18n.unit.ounce.short":        {Other: "{0} oz"},
+		"i18n.unit.ounce.narrow":       {Other: "{0}oz"},
+		"i18n.unit.pound.long":         {Other: "{0} pon"},
+		"i18n.unit.pound.short":        {Other: "{0} lb"},
+		"i18n.unit.pound.narrow":       {Other: "{0}lb"},
+		"i18n.unit.centimeter.long":    {Other: "{0} sentimeter"},
+		"i18n.unit.centimeter.short":   {Other: "{0} cm"},
+		"i18n.unit.centimeter.narrow":  {Other: "{0}cm"},
+		"i18n.unit.meter.long":         {Other: "{0} meter"},
+		"i18n.unit.meter.short":        {Other: "{0} m"},
+		"i18n.unit.meter.narrow":       {Other: "{0}m"},
+		"i18n.unit.kilometer.long":     {Other: "{0} kilometer"},
+		"i18n.unit.kilometer.short":    {Other: "{0} km"},
+		"i18n.unit.kilometer.narrow":   {Other: "{0}km"},
+		"i18n.unit.inch.long":          {Other: "{0} inci"},
+		"i18n.unit.inch.short":         {Other: "{0} in"},
+		"i18n.unit.inch.narrow":        {Other: "{0}in"},
+		"i18n.unit.foot.long":          {Other: "{0} kaki"},
+		"i18n.unit.foot.short":         {Other: "{0} ft"},
+		"i18n.unit.foot.narrow":        {Other: "{0}ft"},
+		"i18n.unit.mile.long":          {Other: "{0} mil"},
+		"i18n.unit.mile.short":         {Other: "{0} mi"},
+		"i18n.unit.mile.narrow":        {Other: "{0}mi"},
+		"i18n.unit.liter.long":         {Other: "{0} liter"},
+		"i18n.unit.liter.short":        {Other: "{0} l"},
+		"i18n.unit.liter.narrow":       {Other: "{0}l"},
+		"i18n.unit.gallon.long":        {Other: "{0} galon"},
+		"i18n.unit.gallon.short":       {Other: "{0} gal"},
+		"i18n.unit.gallon.narrow":      {Other: "{0}gal"},
+		"i18n.unit.celsius.long":       {Other: "{0} derajat Celsius"},
+		"i18n.unit.celsius.short":      {Other: "{0}°C"},
+		"i18n.unit.celsius.narrow":     {Other: "{0}°C"},
+		"i18n.unit.fahrenheit.long":    {Other: "{0} derajat Fahrenheit"},
+		"i18n.unit.fahrenheit.short":   {Other: "{0}°F"},
+		"i18n.unit.fahrenheit.narrow":  {Other: "{0}°F"},
+		"i18n.unit.second.long":        {Other: "{0} detik"},
+		"i18n.unit.second.short":       {Other: "{0} dtk"},
+		"i18n.unit.second.narrow":      {Other: "{0}dtk"},
+		"i18n.unit.minute.long":        {Other: "{0} menit"},
+		"i18n.unit.minute.short":       {Other: "{0} mnt"},
+		"i18n.unit.minute.narrow":      {Other: "{0}mnt"},
+		"i18n.unit.hour.long":          {Other: "{0} jam"},
+		"i18n.unit.hour.short":         {Other: "{0} j"},
+		"i18n.unit.hour.narrow":        {Other: "{0}j"},
+		"i18n.unit.day.long":           {Other: "{0} hari"},
+		"i18n.unit.day.short":          {Other: "{0} hr"},
+		"i18n.unit.day.narrow":         {Other: "{0}h"},
+		"i18n.unit.week.long":          {Other: "{0} minggu"},
+		"i18n.unit.week.short":         {Other: "{0} mgg"},
+		"i18n.unit.week.narrow":        {Other: "{0}mgg"},
+		"i18n.language.id":             {Other: "Bahasa Indonesia"},
+	},
+	"ar": {
+		"i18n.list.and.start": {Other: "{0} و{1}"},
+		"i18n.list.and.end":   {Other: "{0} و{1}"},
+		"i18n.list.and.two":   {Other: "{0} و{1}"},
+		"i18n.list.or.start":  {Other: "{0} أو {1}"},
+		"i18n.list.or.end":    {Other: "{0} أو {1}"},
+		"i18n.list.or.two":    {Other: "{0} أو {1}"},
+	},
+	"de": {
+		"i18n.date.full":               {Other: "EEEE, d. MMMM y"},
+		"i18n.date.long":               {Other: "d. MMMM y"},
+		"i18n.date.medium":             {Other: "dd.MM.y"},
+		"i18n.date.short":              {Other: "dd.MM.yy"},
+		"i18n.time.full":               {Other: "HH:mm:ss zzzz"},
+		"i18n.time.long":               {Other: "HH:mm:ss z"},
+		"i18n.time.medium":             {Other: "HH:mm:ss"},
+		"i18n.time.short":              {Other: "HH:mm"},
+		"i18n.date.months.wide":        {Other: "Januar,Februar,März,April,Mai,Juni,Juli,August,September,Oktober,November,Dezember"},
+		"i18n.date.months.abbreviated": {Other: "Jan.,Feb.,März,Apr.,Mai,Juni,Juli,Aug.,Sept.,Okt.,Nov.,Dez."},
+		"i18n.date.days.wide":          {Other: "Sonntag,Montag,Dienstag,Mittwoch,Donnerstag,Freitag,Samstag"},
+		"i18n.date.days.abbreviated":   {Other: "So.,Mo.,Di.,Mi.,Do.,Fr.,Sa."},
+		"i18n.date.dayPeriods":         {Other: "AM,PM"},
+		"i18n.relative.now":            {Other: "jetzt"},
+		"i18n.relative.second.past":    {One: "vor {{.Count}} Sekunde", Other: "vor {{.Count}} Sekunden"},
+		"i18n.relative.second.future":  {One: "in {{.Count}} Sekunde", Other: "in {{.Count}} Sekunden"},
+		"i18n.relative.minute.past":    {One: "vor {{.Count}} Minute", Other: "vor {{.Count}} Minuten"},
+		"i18n.relative.minute.future":  {One: "in {{.Count}} Minute", Other: "in {{.Count}} Minuten"},
+		"i18n.relative.hour.past":      {One: "vor {{.Count}} Stunde", Other: "vor {{.Count}} Stunden"},
+		"i18n.relative.hour.future":    {One: "in {{.Count}} Stunde", Other: "in {{.Count}} Stunden"},
+		"i18n.relative.day.past":       {One: "vor {{.Count}} Tag", Other: "vor {{.Count}} Tagen"},
+		"i18n.relative.day.future":     {One: "in {{.Count}} Tag", Other: "in {{.Count}} Tagen"},
+		"i18n.relative.week.past":      {One: "vor {{.Count}} Woche", Other: "vor {{.Count}} Wochen"},
+		"i18n.relative.week.future":    {One: "in {{.Count}} Woche", Other: "in {{.Count}} Wochen"},
+		"i18n.relative.month.past":     {One: "vor {{.Count}} Monat", Other: "vor {{.Count}} Monaten"},
+		"i18n.relative.month.future":   {One: "in {{.Count}} Monat", Other: "in {{.Count}} Monaten"},
+		"i18n.relative.year.past":      {One: "vor {{.Count}} Jahr", Other: "vor {{.Count}} Jahren"},
+		"i18n.relative.year.future":    {One: "in {{.Count}} Jahr", Other: "in {{.Count}} Jahren"},
+		"i18n.list.and.start":          {Other: "{0}, {1}"},
+		"i18n.list.and.end":            {Other: "{0} und {1}"},
+		"i18n.list.and.two":            {Other: "{0} und {1}"},
+		"i18n.list.or.start":           {Other: "{0}, {1}"},
+		"i18n.list.or.end":             {Other: "{0} oder {1}"},
+		"i18n.list.or.two":             {Other: "{0} oder {1}"},
+	},
+	"es": {
+		"i18n.date.full":               {Other: "EEEE, d 'de' MMMM 'de' y"},
+		"i18n.date.long":               {Other: "d 'de' MMMM 'de' y"},
+		"i18n.date.medium":             {Other: "d MMM y"},
+		"i18n.date.short":              {Other: "d/M/yy"},
+		"i18n.time.full":               {Other: "H:mm:ss (zzzz)"},
+		"i18n.time.long":               {Other: "H:mm:ss z"},
+		"i18n.time.medium":             {Other: "H:mm:ss"},
+		"i18n.time.short":              {Other: "H:mm"},
+		"i18n.date.months.wide":        {Other: "enero,febrero,marzo,abril,mayo,junio,julio,agosto,septiembre,octubre,noviembre,diciembre"},
+		"i18n.date.months.abbreviated": {Other: "ene,feb,mar,abr,may,jun,jul,ago,sept,oct,nov,dic"},
+		"i18n.date.days.wide":          {Other: "domingo,lunes,martes,miércoles,jueves,viernes,sábado"},
+		"i18n.date.days.abbreviated":   {Other: "dom,lun,mar,mié,jue,vie,sáb"},
+		"i18n.date.dayPeriods":         {Other: "a. m.,p. m."},
+		"i18n.relative.now":            {Other: "ahora"},
+		"i18n.relative.second.past":    {One: "hace {{.Count}} segundo", Other: "hace {{.Count}} segundos"},
+		"i18n.relative.second.future":  {One: "dentro de {{.Count}} segundo", Other: "dentro de {{.Count}} segundos"},
+		"i18n.relative.minute.past":    {One: "hace {{.Count}} minuto", Other: "hace {{.Count}} minutos"},
+		"i18n.relative.minute.future":  {One: "dentro de {{.Count}} minuto", Other: "dentro de {{.Count}} minutos"},
+		"i18n.relative.hour.past":      {One: "hace {{.Count}} hora", Other: "hace {{.Count}} horas"},
+		"i18n.relative.hour.future":    {One: "dentro de {{.Count}} hora", Other: "dentro de {{.Count}} horas"},
+		"i18n.relative.day.past":       {One: "hace {{.Count}} día", Other: "hace {{.Count}} días"},
+		"i18n.relative.day.future":     {One: "dentro de {{.Count}} día", Other: "dentro de {{.Count}} días"},
+		"i18n.relative.week.past":      {One: "hace {{.Count}} semana", Other: "hace {{.Count}} semanas"},
+		"i18n.relative.week.future":    {One: "dentro de {{.Count}} semana", Other: "dentro de {{.Count}} semanas"},
+		"i18n.relative.month.past":     {One: "hace {{.Count}} mes", Other: "hace {{.Count}} meses"},
+		"i18n.relative.month.future":   {One: "dentro de {{.Count}} mes", Other: "dentro de {{.Count}} meses"},
+		"i18n.relative.year.past":      {One: "hace {{.Count}} año", Other: "hace {{.Count}} años"},
+		"i18n.relative.year.future":    {One: "dentro de {{.Count}} año", Other: "dentro de {{.Count}} años"},
+		"i18n.list.and.start":          {Other: "{0}, {1}"},
+		"i18n.list.and.end":            {Other: "{0} y {1}"},
+		"i18n.list.and.two":            {Other: "{0} y {1}"},
+		"i18n.list.or.start":           {Other: "{0}, {1}"},
+		"i18n.list.or.end":             {Other: "{0} o {1}"},
+		"i18n.list.or.two":             {Other: "{0} o {1}"},
+	},
+	"fr": {
+		"i18n.date.full":               {Other: "EEEE d MMMM y"},
+		"i18n.date.long":               {Other: "d MMMM y"},
+		"i18n.date.medium":             {Other: "d MMM y"},
+		"i18n.date.short":              {Other: "dd/MM/y"},
+		"i18n.time.full":               {Other: "HH:mm:ss zzzz"},
+		"i18n.time.long":               {Other: "HH:mm:ss z"},
+		"i18n.time.medium":             {Other: "HH:mm:ss"},
+		"i18n.time.short":              {Other: "HH:mm"},
+		"i18n.date.months.wide":        {Other: "janvier,février,mars,avril,mai,juin,juillet,août,septembre,octobre,novembre,décembre"},
+		"i18n.date.months.abbreviated": {Other: "janv.,févr.,mars,avr.,mai,juin,juil.,août,sept.,oct.,nov.,déc."},
+		"i18n.date.days.wide":          {Other: "dimanche,lundi,mardi,mercredi,jeudi,vendredi,samedi"},
+		"i18n.date.days.abbreviated":   {Other: "dim.,lun.,mar.,mer.,jeu.,ven.,sam."},
+		"i18n.date.dayPeriods":         {Other: "AM,PM"},
+		"i18n.relative.now":            {Other: "maintenant"},
+		"i18n.relative.second.past":    {One: "il y a {{.Count}} seconde", Other: "il y a {{.Count}} secondes"},
+		"i18n.relative.second.future":  {One: "dans {{.Count}} seconde", Other: "dans {{.Count}} secondes"},
+		"i18n.relative.minute.past":    {One: "il y a {{.Count}} minute", Other: "il y a {{.Count}} minutes"},
+		"i18n.relative.minute.future":  {One: "dans {{.Count}} minute", Other: "dans {{.Count}} minutes"},
+		"i18n.relative.hour.past":      {One: "il y a {{.Count}} heure", Other: "il y a {{.Count}} heures"},
+		"i18n.relative.hour.future":    {One: "dans {{.Count}} heure", Other: "dans {{.Count}} heures"},
+		"i18n.relative.day.past":       {One: "il y a {{.Count}} jour", Other: "il y a {{.Count}} jours"},
+		"i18n.relative.day.future":     {One: "dans {{.Count}} jour", Other: "dans {{.Count}} jours"},
+		"i18n.relative.week.past":      {One: "il y a {{.Count}} semaine", Other: "il y a {{.Count}} semaines"},
+		"i18n.relative.week.future":    {One: "dans {{.Count}} semaine", Other: "dans {{.Count}} semaines"},
+		"i18n.relative.month.past":     {One: "il y a {{.Count}} mois", Other: "il y a {{.Count}} mois"},
+		"i18n.relative.month.future":   {One: "dans {{.Count}} mois", Other: "dans {{.Count}} mois"},
+		"i18n.relative.year.past":      {One: "il y a {{.Count}} an", Other: "il y a {{.Count}} ans"},
+		"i18n.relative.year.future":    {One: "dans {{.Count}} an", Other: "dans {{.Count}} ans"},
+		"i18n.list.and.start":          {Other: "{0}, {1}"},
+		"i18n.list.and.end":            {Other: "{0} et {1}"},
+		"i18n.list.and.two":            {Other: "{0} et {1}"},
+		"i18n.list.or.start":           {Other: "{0}, {1}"},
+		"i18n.list.or.end":             {Other: "{0} ou {1}"},
+		"i18n.list.or.two":             {Other: "{0} ou {1}"},
+	},
+	"ja": {
+		"i18n.date.full":               {Other: "y年M月d日EEEE"},
+		"i18n.date.long":               {Other: "y年M月d日"},
+		"i18n.date.medium":             {Other: "y/MM/dd"},
+		"i18n.date.short":              {Other: "y/MM/dd"},
+		"i18n.time.full":               {Other: "H時mm分ss秒 zzzz"},
+		"i18n.time.long":               {Other: "H:mm:ss z"},
+		"i18n.time.medium":             {Other: "H:mm:ss"},
+		"i18n.time.short":              {Other: "H:mm"},
+		"i18n.date.months.wide":        {Other: "1月,2月,3月,4月,5月,6月,7月,8月,9月,10月,11月,12月"},
+		"i18n.date.months.abbreviated": {Other: "1月,2月,3月,4月,5月,6月,7月,8月,9月,10月,11月,12月"},
+		"i18n.date.days.wide":          {Other: "日曜日,月曜日,火曜日,水曜日,木曜日,金曜日,土曜日"},
+		"i18n.date.days.abbreviated":   {Other: "日,月,火,水,木,金,土"},
+		"i18n.date.dayPeriods":         {Other: "午前,午後"},
+		"i18n.relative.now":            {Other: "今"},
+		"i18n.relative.second.past":    {Other: "{{.Count}} 秒前"},
+		"i18n.relative.second.future":  {Other: "{{.Count}} 秒後"},
+		"i18n.relative.minute.past":    {Other: "{{.Count}} 分前"},
+		"i18n.relative.minute.future":  {Other: "{{.Count}} 分後"},
+		"i18n.relative.hour.past":      {Other: "{{.Count}} 時間前"},
+		"i18n.relative.hour.future":    {Other: "{{.Count}} 時間後"},
+		"i18n.relative.day.past":       {Other: "{{.Count}} 日前"},
+		"i18n.relative.day.future":     {Other: "{{.Count}} 日後"},
+		"i18n.relative.week.past":      {Other: "{{.Count}} 週間前"},
+		"i18n.relative.week.future":    {Other: "{{.Count}} 週間後"},
+		"i18n.relative.month.past":     {Other: "{{.Count}} か月前"},
+		"i18n.relative.month.future":   {Other: "{{.Count}} か月後"},
+		"i18n.relative.year.past":      {Other: "{{.Count}} 年前"},
+		"i18n.relative.year.future":    {Other: "{{.Count}} 年後"},
+		"i18n.list.and.start":          {Other: "{0}、{1}"},
+		"i18n.list.and.end":            {Other: "{0}、{1}"},
+		"i18n.list.and.two":            {Other: "{0}、{1}"},
+		"i18n.list.or.start":           {Other: "{0}、{1}"},
+		"i18n.list.or.end":             {Other: "{0}、または{1}"},
+		"i18n.list.or.two":             {Other: "{0}または{1}"},
+		"i18n.unit.gram.long":          {Other: "{0} グラム"},
+		"i18n.unit.gram.short":         {Other: "{0} g"},
+		"i18n.unit.gram.narrow":        {Other: "{0}g"},
+		"i18n.unit.kilogram.long":      {Other: "{0} キログラム"},
+		"i18n.unit.kilogram.short":     {Other: "{0} kg"},
+		"i18n.unit.kilogram.narrow":    {Other: "{0}kg"},
+		"i18n.unit.ounce.long":         {Other: "{0} オンス"},
+		"i18n.unit.ounce.short":        {Other: "{0} oz"},
+		"i18n.unit.ounce.narrow":       {Other: "{0}oz"},
+		"i18n.unit.pound.long":         {Other: "{0} ポンド"},
+		"i18n.unit.pound.short":        {Other: "{0} lb"},
+		"i18n.unit.pound.narrow":       {Other: "{0}lb"},
+		"i18n.unit.centimeter.long":    {Other: "{0} センチメートル"},
+		"i18n.unit.centimeter.short":   {Other: "{0} cm"},
+		"i18n.unit.centimeter.narrow":  {Other: "{0}cm"},
+		"i18n.unit.meter.long":         {Other: "{0} メートル"},
+		"i18n.unit.meter.short":        {Other: "{0} m"},
+		"i18n.unit.meter.narrow":       {Other: "{0}m"},
+		"i18n.unit.kilometer.long":     {Other: "{0} キロメートル"},
+		"i18n.unit.kilometer.short":    {Other: "{0} km"},
+		"i18n.unit.kilometer.narrow":   {Other: "{0}km"},
+		"i18n.unit.inch.long":          {Other: "{0} インチ"},
+		"i18n.unit.inch.short":         {Other: "{0} in"},
+		"i18n.unit.inch.narrow":        {Other: "{0}in"},
+		"i18n.unit.foot.long":          {Other: "{0} フィート"},
+		"i18n.unit.foot.short":         {Other: "{0} ft"},
+		"i18n.unit.foot.narrow":        {Other: "{0}ft"},
+		"i18n.unit.mile.long":          {Other: "{0} マイル"},
+		"i18n.unit.mile.short":         {Other: "{0} mi"},
+		"i18n.unit.mile.narrow":        {Other: "{0}mi"},
+		"i18n.unit.liter.long":         {Other: "{0} リットル"},
+		"i18n.unit.liter.short":        {Other: "{0} L"},
+		"i18n.unit.liter.narrow":       {Other: "{0}L"},
+		"i18n.unit.gallon.long":        {Other: "{0} ガロン"},
+		"i18n.unit.gallon.short":       {Other: "{0} gal"},
+		"i18n.unit.gallon.narrow":      {Other: "{0}gal"},
+		"i18n.unit.celsius.long":       {Other: "摂氏 {0} 度"},
+		"i18n.unit.celsius.short":      {Other: "{0}°C"},
+		"i18n.unit.celsius.narrow":     {Other: "{0}°C"},
+		"i18n.unit.fahrenheit.long":    {Other: "華氏 {0} 度"},
+		"i18n.unit.fahrenheit.short":   {Other: "{0}°F"},
+		"i18n.unit.fahrenheit.narrow":  {Other: "{0}°F"},
+		"i18n.unit.second.long":        {Other: "{0} 秒"},
+		"i18n.unit.second.short":       {Other: "{0} 秒"},
+		"i18n.unit.second.narrow":      {Other: "{0}秒"},
+		"i18n.unit.minute.long":        {Other: "{0} 分"},
+		"i18n.unit.minute.short":       {Other: "{0} 分"},
+		"i18n.unit.minute.narrow":      {Other: "{0}分"},
+		"i18n.unit.hour.long":          {Other: "{0} 時間"},
+		"i18n.unit.hour.short":         {Other: "{0} 時間"},
+		"i18n.unit.hour.narrow":        {Other: "{0}時間"},
+		"i18n.unit.day.long":           {Other: "{0} 日"},
+		"i18n.unit.day.short":          {Other: "{0} 日"},
+		"i18n.unit.day.narrow":         {Other: "{0}日"},
+		"i18n.unit.week.long":          {Other: "{0} 週間"},
+		"i18n.unit.week.short":         {Other: "{0} 週間"},
+		"i18n.unit.week.narrow":        {Other: "{0}週間"},
+	},
+	"zh": {
+		"i18n.date.full":               {Other: "y年M月d日EEEE"},
+		"i18n.date.long":               {Other: "y年M月d日"},
+		"i18n.date.medium":             {Other: "y年M月d日"},
+		"i18n.date.short":              {Other: "y/M/d"},
+		"i18n.time.full":               {Other: "zzzz HH:mm:ss"},
+		"i18n.time.long":               {Other: "z HH:mm:ss"},
+		"i18n.time.medium":             {Other: "HH:mm:ss"},
+		"i18n.time.short":              {Other: "HH:mm"},
+		"i18n.date.months.wide":        {Other: "一月,二月,三月,四月,五月,六月,七月,八月,九月,十月,十一月,十二月"},
+		"i18n.date.months.abbreviated": {Other: "1月,2月,3月,4月,5月,6月,7月,8月,9月,10月,11月,12月"},
+		"i18n.date.days.wide":          {Other: "星期日,星期一,星期二,星期三,星期四,星期五,星期六"},
+		"i18n.date.days.abbreviated":   {Other: "周日,周一,周二,周三,周四,周五,周六"},
+		"i18n.date.dayPeriods":         {Other: "上午,下午"},
+		"i18n.relative.now":            {Other: "现在"},
+		"i18n.relative.second.past":    {Other: "{{.Count}}秒钟前"},
+		"i18n.relative.second.future":  {Other: "{{.Count}}秒钟后"},
+		"i18n.relative.minute.past":    {Other: "{{.Count}}分钟前"},
+		"i18n.relative.minute.future":  {Other: "{{.Count}}分钟后"},
+		"i18n.relative.hour.past":      {Other: "{{.Count}}小时前"},
+		"i18n.relative.hour.future":    {Other: "{{.Count}}小时后"},
+		"i18n.relative.day.past":       {Other: "{{.Count}}天前"},
+		"i18n.relative.day.future":     {Other: "{{.Count}}天后"},
+		"i18n.relative.week.past":      {Other: "{{.Count}}周前"},
+		"i18n.relative.week.future":    {Other: "{{.Count}}周后"},
+		"i18n.relative.month.past":     {Other: "{{.Count}}个月前"},
+		"i18n.relative.month.future":   {Other: "{{.Count}}个月后"},
+		"i18n.relative.year.past":      {Other: "{{.Count}}年前"},
+		"i18n.relative.year.future":    {Other: "{{.Count}}年后"},
+		"i18n.list.and.start":          {Other: "{0}、{1}"},
+		"i18n.list.and.end":            {Other: "{0}和{1}"},
+		"i18n.list.and.two":            {Other: "{0}和{1}"},
+		"i18n.list.or.start":           {Other: "{0}、{1}"},
+		"i18n.list.or.end":             {Other: "{0}或{1}"},
+		"i18n.list.or.two":             {Other: "{0}或{1}"},
+	},
+}
@@ -17,6 +17,10 @@ type localizeConfig struct {
 	params         map[string]any
 	defaultMessage string
 	language       string
+	count          any
+	namespace      string
+	selections     []selection
+	isolateParams  bool
 }
 
 func newLocalizeConfig(opts ...any) *localizeConfig {
@@ -45,15 +49,48 @@ func (c *localizeConfig) toI18nLocalizeConfig(id string) *i18n.LocalizeConfig {
 		MessageID:    id,
 		TemplateData: c.params,
 	}
+	if c.isolateParams {
+		for key, value := range c.params {
+			if c.isSelector(key) {
+				continue
+			}
+			if text, ok := value.(string); ok && !isIsolated(text) {
+				c.params[key] = Isolate(text)
+			}
+		}
+	}
+	if c.count != nil {
+		localizeConfig.PluralCount = c.count
+		if _, ok := c.params["Count"]; !ok {
+			c.params["Count"] = c.count
+		}
+	}
+	for _, s := range c.selections {
+		if _, ok := c.params[s.key]; !ok {
+			c.params[s.key] = s.value
+		}
+	}
 	if c.defaultMessage != "" {
 		localizeConfig.DefaultMessage = &i18n.Message{
 			ID:    id,
 			Other: c.defaultMessage,
 		}
+		setMessageSyntax(messageSyntax, localizeConfig.DefaultMessage)
 	}
 	return localizeConfig
 }
 
+// isSelector reports whether the param is the value of a Select option, which selects the variant
+// of the message rather than being interpolated.
+func (c *localizeConfig) isSelector(key string) bool {
+	for _, s := range c.selections {
+		if s.key == key {
+			return true
+		}
+	}
+	return false
+}
+
 // LocalizeOption is a function that configures the localizeConfig.
 type LocalizeOption func(*localizeConfig)
 
@@ -91,3 +128,17 @@ func Default(defaultMessage string) LocalizeOption {
 		c.defaultMessage = defaultMessage
 	}
 }
+
+// Count sets the plural count for the message.
+//
+// It selects the plural form of the message (one, few, many, other, ...) and is available
+// in the template as {{.Count}} unless a "Count" param is set explicitly.
+//
+// Example:
+//
+//	i18n.T("items", i18n.Count(3))
+func Count(count any) LocalizeOption {
+	return func(c *localizeConfig) {
+		c.count = count
+	}
+}
@@ -0,0 +1,152 @@
+package i18n
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const localizerCtxKey contextKey = "i18n-localizer"
+
+// Localizer translates messages and formats values in the language resolved once for a context,
+// so handlers translating many messages don't extract the language from the context for every call.
+//
+// Get one with FromContext, NewMiddleware adds it to the request context.
+type Localizer struct {
+	ctx     context.Context
+	lang    string
+	format  language.Tag
+	matched language.Tag
+}
+
+// FromContext returns the localizer of the language of the context.
+//
+// It reuses the localizer added by NewMiddleware if the language of the context didn't change since.
+//
+// Example:
+//
+//	l := i18n.FromContext(r.Context())
+//	title := l.T("title")
+//	items := l.TN("items", 3)
+func FromContext(ctx context.Context) *Localizer {
+	var lang string
+	if extractLanguageFunc != nil {
+		lang = extractLanguageFunc(ctx)
+	}
+	if lazy, ok := ctx.Value(localizerCtxKey).(*lazyLocalizer); ok {
+		if l := lazy.get(); l.lang == lang {
+			if l.ctx == ctx {
+				return l
+			}
+			localizer := *l
+			localizer.ctx = ctx
+			return &localizer
+		}
+	}
+	return newLocalizer(ctx, lang)
+}
+
+// lazyLocalizer is the localizer added to the context by NewMiddleware,
+// it is built by the first FromContext, so requests that don't use it don't pay for it.
+type lazyLocalizer struct {
+	once      sync.Once
+	ctx       context.Context
+	localizer *Localizer
+}
+
+func (l *lazyLocalizer) get() *Localizer {
+	l.once.Do(func() {
+		var lang string
+		if extractLanguageFunc != nil {
+			lang = extractLanguageFunc(l.ctx)
+		}
+		l.localizer = newLocalizer(l.ctx, lang)
+	})
+	return l.localizer
+}
+
+// withLocalizer adds the localizer of the language of the context to the context.
+func withLocalizer(ctx context.Context) context.Context {
+	lazy := &lazyLocalizer{}
+	ctx = context.WithValue(ctx, localizerCtxKey, lazy)
+	lazy.ctx = ctx
+	return ctx
+}
+
+func newLocalizer(ctx context.Context, lang string) *Localizer {
+	l := &Localizer{ctx: ctx, lang: lang, format: defaultLanguage}
+	if tags := parseLanguages([]string{lang}); len(tags) > 0 {
+		l.format = tags[0]
+	}
+	l.matched = servedLanguage(l.format)
+	return l
+}
+
+// T returns the translated message for the given message id, like GetCtx.
+func (l *Localizer) T(id string, opts ...any) string {
+	if bundle == nil {
+		return "ERROR: i18n is not initialized"
+	}
+	return localize(l.ctx, l.lang, id, opts...)
+}
+
+// TN returns the translated plural message for the count, like GetCtx with the Count option.
+//
+// Example:
+//
+//	message := l.TN("items", 3) // 3 items
+func (l *Localizer) TN(id string, count any, opts ...any) string {
+	return l.T(id, append(opts, Count(count))...)
+}
+
+// Lang returns the loaded language that messages are translated in.
+func (l *Localizer) Lang() language.Tag {
+	return l.matched
+}
+
+// Dir returns the text direction of the language, e.g. for the dir attribute of HTML elements.
+func (l *Localizer) Dir() TextDirection {
+	return DirectionOf(l.matched)
+}
+
+// Number formats the number like FormatNumber.
+func (l *Localizer) Number(value any) string {
+	return formatNumber(l.format, value)
+}
+
+// Percent formats the ratio as a percentage like FormatPercent.
+func (l *Localizer) Percent(value any) string {
+	return formatPercent(l.format, value)
+}
+
+// Currency formats the amount of the ISO 4217 currency like FormatCurrency.
+func (l *Localizer) Currency(amount any, code string) string {
+	return formatCurrency(l.format, amount, code)
+}
+
+// Date formats the date like FormatDate.
+func (l *Localizer) Date(t time.Time, style DateStyle) string {
+	return formatDate(l.format, t, dateMessagePrefix, style)
+}
+
+// Time formats the time of day like FormatTime.
+func (l *Localizer) Time(t time.Time, style DateStyle) string {
+	return formatDate(l.format, t, timeMessagePrefix, style)
+}
+
+// RelativeTime formats the time relative to now like FormatRelativeTime.
+func (l *Localizer) RelativeTime(t time.Time) string {
+	return formatRelativeTime(l.format, t)
+}
+
+// List formats the items like FormatList.
+func (l *Localizer) List(items []string, style ListStyle) string {
+	return formatList(l.format, items, style)
+}
+
+// Unit formats the value of the unit like FormatUnit.
+func (l *Localizer) Unit(value any, unit Unit, width UnitWidth) string {
+	return formatUnit(l.format, value, unit, width)
+}
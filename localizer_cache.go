@@ -0,0 +1,151 @@
+package i18n
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// defaultLocalizerCacheSize is the number of language preference lists localizers are cached for.
+const defaultLocalizerCacheSize = 256
+
+// cachedLocalizer is a localizer for a language preference list along with the languages matched for it,
+// so lookups don't parse and match the languages again.
+type cachedLocalizer struct {
+	key       string
+	localizer *i18n.Localizer
+	// languages are the requested languages followed by the default language.
+	languages []string
+	// requested are the parsed requested languages.
+	requested []language.Tag
+	// tags are the parsed languages.
+	tags []language.Tag
+	// tag is the loaded language that best matches the languages.
+	tag language.Tag
+	// requestedTag is the loaded language that best matches the requested languages.
+	requestedTag language.Tag
+	// format is the language values are formatted in, the first requested language or the default language.
+	format language.Tag
+	// parsers are the message parsers of the languages messages are served in formatting in the format language,
+	// kept with the localizer so they are bounded by the cache.
+	parsers sync.Map
+	// unmatched reports whether none of the requested languages is loaded, requestedTag is then
+	// the default language and every message is served in a fallback language.
+	unmatched bool
+}
+
+// localizerCache is a bounded LRU cache of localizers keyed by the normalized language preference list.
+type localizerCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// localizers caches the localizers of the bundle, it is replaced when the bundle is reloaded.
+var localizers *localizerCache
+
+func newLocalizerCache(size int) *localizerCache {
+	return &localizerCache{size: size, entries: make(map[string]*list.Element), order: list.New()}
+}
+
+// get returns the localizer for the requested languages, creating it on a cache miss.
+func (c *localizerCache) get(requested []string) *cachedLocalizer {
+	if c == nil || c.size <= 0 {
+		return newCachedLocalizer("", requested)
+	}
+	key := localizerKey(requested)
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*cachedLocalizer)
+	}
+	c.mu.Unlock()
+
+	// Creating the localizer outside the lock lets concurrent misses proceed, the last one wins.
+	entry := newCachedLocalizer(key, requested)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return element.Value.(*cachedLocalizer)
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedLocalizer).key)
+	}
+	return entry
+}
+
+// len returns the number of cached localizers.
+func (c *localizerCache) len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func newCachedLocalizer(key string, requested []string) *cachedLocalizer {
+	languages := slices.Clone(requested)
+	if !slices.Contains(languages, defaultLanguage.String()) {
+		languages = append(languages, defaultLanguage.String())
+	}
+	entry := &cachedLocalizer{
+		key:       key,
+		localizer: i18n.NewLocalizer(bundle, languages...),
+		languages: languages,
+		requested: parseLanguages(requested),
+		tags:      parseLanguages(languages),
+	}
+	entry.tag = matchLanguage(entry.tags...)
+	entry.requestedTag = entry.tag
+	entry.format = defaultLanguage
+	if len(entry.requested) > 0 {
+		var confidence language.Confidence
+		entry.requestedTag, confidence = matchLanguageConfidence(entry.requested...)
+		entry.unmatched = confidence == language.No
+		entry.format = entry.requested[0]
+	}
+	return entry
+}
+
+// parserOf returns the message parser of messages of the language formatting in the format language.
+func (c *cachedLocalizer) parserOf(tag language.Tag) *messageParser {
+	if tag == c.format {
+		return messageParserOf(tag)
+	}
+	if parser, ok := c.parsers.Load(tag); ok {
+		return parser.(*messageParser)
+	}
+	parser, _ := c.parsers.LoadOrStore(tag, newMessageParser(tag, c.format))
+	return parser.(*messageParser)
+}
+
+// localizerKey normalizes the language preference list, so "en_US" and "en-us" share a localizer.
+func localizerKey(languages []string) string {
+	if len(languages) == 1 {
+		return normalizeLanguage(languages[0])
+	}
+	var b strings.Builder
+	for i, lang := range languages {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(normalizeLanguage(lang))
+	}
+	return b.String()
+}
+
+func normalizeLanguage(lang string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(lang)), "_", "-")
+}
@@ -0,0 +1,92 @@
+package i18n_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLocalizerCache(t *testing.T) {
+	initI18n(t, i18n.WithLocalizerCacheSize(2))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		opts     []any
+		expected string
+		cached   int
+	}{
+		{name: "default language", lang: "", expected: "Hello", cached: 1},
+		{name: "requested language", lang: "id", expected: "Halo", cached: 2},
+		{name: "normalized language", lang: "ID", expected: "Halo", cached: 2},
+		{name: "evicts least recently used", lang: "id", opts: []any{i18n.Lang("fr")}, expected: "Halo", cached: 2},
+		{name: "cached after eviction", lang: "", expected: "Hello", cached: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.GetCtx(ctx, "hello", tc.opts...))
+			assert.Equal(t, tc.cached, i18n.LocalizerCacheLen())
+		})
+	}
+}
+
+func TestLocalizerCacheDisabled(t *testing.T) {
+	initI18n(t, i18n.WithLocalizerCacheSize(0))
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	assert.Equal(t, "Halo", i18n.GetCtx(ctx, "hello"))
+	assert.Equal(t, 0, i18n.LocalizerCacheLen())
+}
+
+func TestLocalizerCacheReload(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "id.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("hello: Halo\n"), 0o600))
+	init := func() {
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithTranslationFile("testdata/en.yaml", path),
+		)
+		require.NoError(t, err)
+	}
+	init()
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	assert.Equal(t, "Halo", i18n.GetCtx(ctx, "hello"))
+	assert.Equal(t, 1, i18n.LocalizerCacheLen())
+
+	require.NoError(t, os.WriteFile(path, []byte("hello: Hai\n"), 0o600))
+	init()
+	assert.Equal(t, 0, i18n.LocalizerCacheLen())
+	assert.Equal(t, "Hai", i18n.GetCtx(ctx, "hello"))
+}
+
+func BenchmarkGetCtx(b *testing.B) {
+	benchmarks := []struct {
+		name      string
+		cacheSize int
+	}{
+		{name: "uncached", cacheSize: 0},
+		{name: "cached", cacheSize: 256},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			initI18n(b, i18n.WithLocalizerCacheSize(bm.cacheSize))
+			ctx := i18n.SetLangToContext(context.Background(), "id")
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				i18n.GetCtx(ctx, "hello_name", i18n.Params{"name": "John"})
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package i18n_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestFromContext(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name          string
+		lang          string
+		expectedHello string
+		expectedItems string
+		expectedOne   string
+		expectedLang  language.Tag
+		expectedDir   i18n.TextDirection
+		expectedNum   string
+	}{
+		{
+			name:          "default language",
+			expectedHello: "Hello, John",
+			expectedItems: "3 items",
+			expectedOne:   "1 item",
+			expectedLang:  language.English,
+			expectedDir:   i18n.LeftToRight,
+			expectedNum:   "1,234.5",
+		},
+		{
+			name:          "requested language",
+			lang:          "id-ID",
+			expectedHello: "Halo, John",
+			expectedItems: "3 barang",
+			expectedOne:   "1 barang",
+			expectedLang:  language.Indonesian,
+			expectedDir:   i18n.LeftToRight,
+			expectedNum:   "1.234,5",
+		},
+		{
+			name:          "unsupported right-to-left language",
+			lang:          "ar",
+			expectedHello: "Hello, John",
+			expectedItems: "3 items",
+			expectedOne:   "1 item",
+			expectedLang:  language.English,
+			expectedDir:   i18n.LeftToRight,
+			expectedNum:   "١٬٢٣٤٫٥",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := i18n.FromContext(i18n.SetLangToContext(context.Background(), tc.lang))
+			assert.Equal(t, tc.expectedHello, l.T("hello_name", i18n.Param("name", "John")))
+			assert.Equal(t, tc.expectedItems, l.TN("items", 3))
+			assert.Equal(t, tc.expectedOne, l.TN("items", 1))
+			assert.Equal(t, tc.expectedLang, l.Lang())
+			assert.Equal(t, tc.expectedDir, l.Dir())
+			assert.Equal(t, tc.expectedNum, l.Number(1234.5))
+		})
+	}
+}
+
+func TestLocalizerFormat(t *testing.T) {
+	initI18n(t)
+	now := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	i18n.SetNow(now)
+
+	for _, lang := range []string{"en", "id"} {
+		t.Run(lang, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), lang)
+			l := i18n.FromContext(ctx)
+			assert.Equal(t, i18n.FormatPercent(ctx, 0.25), l.Percent(0.25))
+			assert.Equal(t, i18n.FormatCurrency(ctx, 1234.5, "IDR"), l.Currency(1234.5, "IDR"))
+			assert.Equal(t, i18n.FormatDate(ctx, now, i18n.DateLong), l.Date(now, i18n.DateLong))
+			assert.Equal(t, i18n.FormatTime(ctx, now, i18n.DateShort), l.Time(now, i18n.DateShort))
+			assert.Equal(t, i18n.FormatRelativeTime(ctx, now.Add(-2*time.Hour)), l.RelativeTime(now.Add(-2*time.Hour)))
+			assert.Equal(t, i18n.FormatList(ctx, []string{"a", "b", "c"}, i18n.ListAnd), l.List([]string{"a", "b", "c"}, i18n.ListAnd))
+			assert.Equal(t, i18n.FormatUnit(ctx, 5, i18n.UnitKilometer, i18n.UnitLong), l.Unit(5, i18n.UnitKilometer, i18n.UnitLong))
+		})
+	}
+}
+
+func TestMiddlewareLocalizer(t *testing.T) {
+	initI18n(t)
+
+	var injected, reused, changed *i18n.Localizer
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		injected = i18n.FromContext(r.Context())
+		reused = i18n.FromContext(r.Context())
+		changed = i18n.FromContext(i18n.SetLangToContext(r.Context(), "en"))
+		_, _ = w.Write([]byte(injected.T("hello_name", i18n.Param("name", "John"))))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "id")
+	rec := httptest.NewRecorder()
+	i18n.NewMiddleware()(handler).ServeHTTP(rec, req)
+
+	assert.Equal(t, "Halo, John", rec.Body.String())
+	assert.Same(t, injected, reused)
+	assert.Equal(t, language.Indonesian, injected.Lang())
+	assert.Equal(t, language.English, changed.Lang())
+	assert.Equal(t, "Hello, John", changed.T("hello_name", i18n.Param("name", "John")))
+}
+
+func BenchmarkMiddleware(b *testing.B) {
+	initI18n(b)
+	handler := i18n.NewMiddleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "id")
+	rec := httptest.NewRecorder()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		handler.ServeHTTP(rec, req)
+	}
+}
@@ -0,0 +1,123 @@
+package i18n
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// logger logs load warnings, missing translations, template errors and reloads, it is nil if logging is disabled.
+var logger *slog.Logger
+
+func logAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	if logger == nil {
+		return
+	}
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// LanguageLogKey is the attribute key of the language added by the handler of NewLogHandler.
+const LanguageLogKey = "lang"
+
+// NewLogHandler wraps the slog handler to add the language resolved for the context of every record
+// as the "lang" attribute, the language messages are served in for the context.
+// The attribute is added at the top level of the record, outside of the groups of the logger.
+//
+// Example:
+//
+//	logger := slog.New(i18n.NewLogHandler(slog.NewJSONHandler(os.Stdout, nil)))
+//	logger.InfoContext(ctx, "order created") // {"msg":"order created","lang":"id",...}
+func NewLogHandler(handler slog.Handler) slog.Handler {
+	return &logHandler{handler: handler}
+}
+
+// logHandler adds the language to the records. Once a group is opened, the language can't be added
+// to the record, which would nest it in the group, so the calls made since the first group are replayed
+// on the handler with the language attribute, once per language.
+type logHandler struct {
+	handler slog.Handler
+
+	// base is the wrapped handler before the first group, calls are the WithAttrs and WithGroup calls
+	// made since and handlers caches the handlers built for each language, all nil without groups.
+	base     slog.Handler
+	calls    []func(slog.Handler) slog.Handler
+	handlers *sync.Map
+}
+
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *logHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.handler.Handle(ctx, record)
+	}
+	lang := resolvedLanguage(ctx).String()
+	if h.base == nil {
+		record.AddAttrs(slog.String(LanguageLogKey, lang))
+		return h.handler.Handle(ctx, record)
+	}
+	handler, ok := h.handlers.Load(lang)
+	if !ok {
+		handler = h.base.WithAttrs([]slog.Attr{slog.String(LanguageLogKey, lang)})
+		for _, call := range h.calls {
+			handler = call(handler.(slog.Handler))
+		}
+		handler, _ = h.handlers.LoadOrStore(lang, handler)
+	}
+	return handler.(slog.Handler).Handle(ctx, record)
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if h.base == nil {
+		return &logHandler{handler: h.handler.WithAttrs(attrs)}
+	}
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithAttrs(attrs) })
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	if h.base == nil {
+		h = &logHandler{handler: h.handler, base: h.handler}
+	}
+	return h.with(func(handler slog.Handler) slog.Handler { return handler.WithGroup(name) })
+}
+
+// with returns the handler with the call applied and recorded to be replayed for each language.
+func (h *logHandler) with(call func(slog.Handler) slog.Handler) *logHandler {
+	return &logHandler{
+		handler:  call(h.handler),
+		base:     h.base,
+		calls:    append(slices.Clip(h.calls), call),
+		handlers: &sync.Map{},
+	}
+}
+
+// resolvedLanguage returns the language messages are served in for the context,
+// the language of the localizer added by NewMiddleware if the language of the context didn't change since.
+func resolvedLanguage(ctx context.Context) language.Tag {
+	if lazy, ok := ctx.Value(localizerCtxKey).(*lazyLocalizer); ok && extractLanguageFunc != nil {
+		if l := lazy.get(); l.lang == extractLanguageFunc(ctx) {
+			return l.matched
+		}
+	}
+	return servedLanguage(contextLanguage(ctx))
+}
+
+// servedLanguage returns the language messages are served in for the requested language:
+// the pseudo-locale if it is one, otherwise the loaded language that best matches it,
+// falling back to the default language like lookups do.
+func servedLanguage(tag language.Tag) language.Tag {
+	if pseudo, ok := pseudoLocale([]language.Tag{tag}); ok {
+		return pseudo
+	}
+	if bundle == nil {
+		return tag
+	}
+	return matchLanguage(tag, defaultLanguage)
+}
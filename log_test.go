@@ -0,0 +1,191 @@
+package i18n_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]any
+		require.NoError(t, decoder.Decode(&record))
+		delete(record, slog.TimeKey)
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/en.yaml", "testdata/id.yaml", "testdata/en.yaml"),
+		i18n.WithLogger(logger),
+	)
+	require.NoError(t, err)
+
+	records := decodeLogRecords(t, &buf)
+	require.NotEmpty(t, records)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, "i18n: message overridden", records[0]["msg"])
+	assert.Equal(t, "testdata/en.yaml", records[0]["path"])
+	assert.Equal(t, "testdata/en.yaml", records[0]["previous_path"])
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	testCases := []struct {
+		name     string
+		lookup   func()
+		expected map[string]any
+	}{
+		{
+			name:   "missing translation",
+			lookup: func() { i18n.GetCtx(ctx, "unknown") },
+			expected: map[string]any{
+				"level":     "WARN",
+				"msg":       "i18n: missing translation",
+				"id":        "unknown",
+				"languages": []any{"id"},
+			},
+		},
+		{
+			name:   "template error",
+			lookup: func() { i18n.GetCtx(context.Background(), "broken", i18n.Default("Hello, {{.name")) },
+			expected: map[string]any{
+				"level":     "ERROR",
+				"msg":       "i18n: failed to execute message template",
+				"id":        "broken",
+				"languages": nil,
+			},
+		},
+		{
+			name: "locale data fallback",
+			lookup: func() {
+				arabic := i18n.SetLangToContext(context.Background(), "ar")
+				i18n.FormatDate(arabic, time.Now(), i18n.DateLong)
+				i18n.FormatDate(arabic, time.Now(), i18n.DateLong)
+			},
+			expected: map[string]any{
+				"level":    "WARN",
+				"msg":      "i18n: no locale data for language, using English",
+				"language": "ar",
+				"id":       "i18n.date.long",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf.Reset()
+			tc.lookup()
+			records := decodeLogRecords(t, &buf)
+			require.NotEmpty(t, records)
+			record := records[0]
+			delete(record, "error")
+			assert.Equal(t, tc.expected, record)
+		})
+	}
+
+	t.Run("translated", func(t *testing.T) {
+		buf.Reset()
+		i18n.GetCtx(ctx, "hello")
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithTranslationFile("testdata/en.yaml"),
+			i18n.WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))),
+		)
+		require.NoError(t, err)
+		records := decodeLogRecords(t, &buf)
+		require.NotEmpty(t, records)
+		assert.Equal(t, "i18n: reloaded messages", records[len(records)-1]["msg"])
+	})
+}
+
+func TestNewLogHandler(t *testing.T) {
+	initI18n(t)
+
+	var buf bytes.Buffer
+	logger := slog.New(i18n.NewLogHandler(slog.NewJSONHandler(&buf, nil))).With("service", "api")
+
+	testCases := []struct {
+		name     string
+		lang     string
+		expected string
+	}{
+		{name: "loaded language", lang: "id", expected: "id"},
+		{name: "regional language", lang: "id-ID", expected: "id"},
+		{name: "unsupported language", lang: "fr", expected: "en"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf.Reset()
+			logger.InfoContext(i18n.SetLangToContext(context.Background(), tc.lang), "order created", "id", 1)
+			records := decodeLogRecords(t, &buf)
+			require.Len(t, records, 1)
+			assert.Equal(t, "api", records[0]["service"])
+			assert.Equal(t, tc.expected, records[0]["lang"])
+			assert.Equal(t, float64(1), records[0]["id"])
+		})
+	}
+
+	t.Run("with groups", func(t *testing.T) {
+		grouped := logger.WithGroup("request").With("method", "GET").WithGroup("user")
+		for _, lang := range []string{"id", "en", "id"} {
+			buf.Reset()
+			grouped.InfoContext(i18n.SetLangToContext(context.Background(), lang), "order created", "id", 1)
+			records := decodeLogRecords(t, &buf)
+			require.Len(t, records, 1)
+			assert.Equal(t, "api", records[0]["service"])
+			assert.Equal(t, lang, records[0]["lang"])
+			assert.Equal(t, map[string]any{"method": "GET", "user": map[string]any{"id": float64(1)}}, records[0]["request"])
+		}
+	})
+}
+
+func BenchmarkLogHandler(b *testing.B) {
+	initI18n(b)
+	logger := slog.New(i18n.NewLogHandler(slog.NewJSONHandler(io.Discard, nil)))
+
+	b.Run("context", func(b *testing.B) {
+		ctx := i18n.SetLangToContext(context.Background(), "id-ID")
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			logger.InfoContext(ctx, "order created")
+		}
+	})
+	b.Run("middleware", func(b *testing.B) {
+		var ctx context.Context
+		handler := i18n.NewMiddleware()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Language", "id-ID")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			logger.InfoContext(ctx, "order created")
+		}
+	})
+}
@@ -0,0 +1,614 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"gopkg.in/yaml.v3"
+)
+
+// UpdateMessageFile writes the messages into the YAML, JSON or TOML message file at path.
+//
+// Existing keys keep their position in the file and keys that are not part of messages are left untouched.
+// Dotted message IDs are matched against nested keys ("auth.login" updates login inside auth),
+// and new messages are appended to the deepest existing parent. The file is created if it doesn't exist.
+func UpdateMessageFile(path string, messages ...*i18n.Message) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = updateYAMLMessages(data, messages)
+	case ".json":
+		data, err = updateJSONMessages(data, messages)
+	case ".toml":
+		data, err = updateTOMLMessages(data, messages)
+	default:
+		return fmt.Errorf("unsupported message file format %q", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// messageFields returns the message keys written to a message file, in file order.
+func messageFields(message *i18n.Message) [][2]string {
+	var fields [][2]string
+	for _, form := range []plural.Form{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other} {
+		if text := getPluralForm(message, form); text != "" {
+			fields = append(fields, [2]string{pluralFormNames[form], text})
+		}
+	}
+	return fields
+}
+
+func updateYAMLMessages(data []byte, messages []*i18n.Message) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("expected a mapping at the top level")
+	}
+
+	for _, message := range messages {
+		parent, key := yamlParent(doc.Content[0], message.ID)
+		value := yamlValue(parent, key)
+		switch {
+		case value != nil && value.Kind == yaml.MappingNode:
+			for _, field := range messageFields(message) {
+				if node := yamlValue(value, field[0]); node != nil {
+					node.Value = field[1]
+				} else {
+					value.Content = append(value.Content, yamlString(field[0]), yamlString(field[1]))
+				}
+			}
+		case value != nil && !isPluralMessage(message):
+			value.Kind, value.Tag, value.Value = yaml.ScalarNode, "!!str", message.Other
+		default:
+			newValue := yamlString(message.Other)
+			if isPluralMessage(message) {
+				newValue = &yaml.Node{Kind: yaml.MappingNode}
+				for _, field := range messageFields(message) {
+					newValue.Content = append(newValue.Content, yamlString(field[0]), yamlString(field[1]))
+				}
+			}
+			if value != nil {
+				*value = *newValue
+			} else {
+				parent.Content = append(parent.Content, yamlString(key), newValue)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlParent returns the deepest mapping that holds the message ID and the remaining key.
+func yamlParent(mapping *yaml.Node, id string) (*yaml.Node, string) {
+	if yamlValue(mapping, id) != nil {
+		return mapping, id
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i].Value, mapping.Content[i+1]
+		if value.Kind == yaml.MappingNode && strings.HasPrefix(id, key+".") && !isMessageNode(value) {
+			return yamlParent(value, strings.TrimPrefix(id, key+"."))
+		}
+	}
+	return mapping, id
+}
+
+func yamlValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlString(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// isMessageNode reports whether the mapping is a message object such as {one: ..., other: ...}.
+func isMessageNode(mapping *yaml.Node) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if _, ok := messageObjectKeys[strings.ToLower(mapping.Content[i].Value)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// messageObjectKeys are the keys of a message object in a message file.
+var messageObjectKeys = map[string]struct{}{
+	"id": {}, "description": {}, "hash": {}, "leftdelim": {}, "rightdelim": {},
+	"zero": {}, "one": {}, "two": {}, "few": {}, "many": {}, "other": {}, "translation": {},
+}
+
+// jsonObject is a JSON object that keeps its key order.
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func (o *jsonObject) get(key string) (any, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+func (o *jsonObject) set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+func updateJSONMessages(data []byte, messages []*i18n.Message) ([]byte, error) {
+	root := &jsonObject{values: make(map[string]any)}
+	if len(bytes.TrimSpace(data)) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		value, err := decodeJSONValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+		object, ok := value.(*jsonObject)
+		if !ok {
+			return nil, errors.New("expected an object at the top level")
+		}
+		root = object
+	}
+
+	for _, message := range messages {
+		parent, key := jsonParent(root, message.ID)
+		value, _ := parent.get(key)
+		if object, ok := value.(*jsonObject); ok {
+			for _, field := range messageFields(message) {
+				object.set(field[0], field[1])
+			}
+			continue
+		}
+		if !isPluralMessage(message) {
+			parent.set(key, message.Other)
+			continue
+		}
+		object := &jsonObject{values: make(map[string]any)}
+		for _, field := range messageFields(message) {
+			object.set(field[0], field[1])
+		}
+		parent.set(key, object)
+	}
+
+	var buf bytes.Buffer
+	writeJSONValue(&buf, root, "")
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func jsonParent(object *jsonObject, id string) (*jsonObject, string) {
+	if _, ok := object.get(id); ok {
+		return object, id
+	}
+	for _, key := range object.keys {
+		child, ok := object.values[key].(*jsonObject)
+		if ok && strings.HasPrefix(id, key+".") && !isMessageObject(child) {
+			return jsonParent(child, strings.TrimPrefix(id, key+"."))
+		}
+	}
+	return object, id
+}
+
+func isMessageObject(object *jsonObject) bool {
+	for _, key := range object.keys {
+		if _, ok := messageObjectKeys[strings.ToLower(key)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		object := &jsonObject{values: make(map[string]any)}
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected object key but got %v", keyToken)
+			}
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object.set(key, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return object, nil
+	case json.Delim('['):
+		var array []any
+		for decoder.More() {
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return array, nil
+	default:
+		return token, nil
+	}
+}
+
+func writeJSONValue(w io.Writer, value any, indent string) {
+	switch v := value.(type) {
+	case *jsonObject:
+		if len(v.keys) == 0 {
+			_, _ = io.WriteString(w, "{}")
+			return
+		}
+		_, _ = io.WriteString(w, "{\n")
+		for i, key := range v.keys {
+			_, _ = io.WriteString(w, indent+"  ")
+			writeJSONValue(w, key, "")
+			_, _ = io.WriteString(w, ": ")
+			writeJSONValue(w, v.values[key], indent+"  ")
+			if i < len(v.keys)-1 {
+				_, _ = io.WriteString(w, ",")
+			}
+			_, _ = io.WriteString(w, "\n")
+		}
+		_, _ = io.WriteString(w, indent+"}")
+	case []any:
+		if len(v) == 0 {
+			_, _ = io.WriteString(w, "[]")
+			return
+		}
+		_, _ = io.WriteString(w, "[\n")
+		for i, item := range v {
+			_, _ = io.WriteString(w, indent+"  ")
+			writeJSONValue(w, item, indent+"  ")
+			if i < len(v)-1 {
+				_, _ = io.WriteString(w, ",")
+			}
+			_, _ = io.WriteString(w, "\n")
+		}
+		_, _ = io.WriteString(w, indent+"]")
+	default:
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		_ = encoder.Encode(v)
+		_, _ = w.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	}
+}
+
+var (
+	tomlTablePattern = regexp.MustCompile(`^\s*\[\s*([^\[\]]+?)\s*\]\s*(#.*)?$`)
+	tomlKeyPattern   = regexp.MustCompile(`^\s*((?:[A-Za-z0-9_-]+|"(?:[^"\\]|\\.)*")(?:\s*\.\s*(?:[A-Za-z0-9_-]+|"(?:[^"\\]|\\.)*"))*)\s*=`)
+	tomlBareKey      = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// tomlValue is the span of a key and its value in a TOML file, from the key line to the line
+// and column after the value, which differ for multiline strings.
+type tomlValue struct {
+	table   string
+	line    int
+	endLine int
+	endCol  int
+}
+
+// updateTOMLMessages edits the TOML file line by line, so comments, formatting
+// and key order of untouched keys are kept as they are.
+func updateTOMLMessages(data []byte, messages []*i18n.Message) ([]byte, error) {
+	var lines []string
+	if len(data) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+
+	// values maps each dotted key path to its value, tables maps each table path to the last line
+	// of its section that isn't blank or a comment.
+	values := make(map[string]tomlValue)
+	tables := make(map[string]int)
+	firstTable := len(lines)
+	table := ""
+	for i := 0; i < len(lines); i++ {
+		if match := tomlTablePattern.FindStringSubmatch(lines[i]); match != nil {
+			table = tomlKeyPath(match[1])
+			tables[table] = i
+			firstTable = min(firstTable, i)
+			continue
+		}
+		if match := tomlKeyPattern.FindStringSubmatch(lines[i]); match != nil {
+			col := len(lines[i]) - len(strings.TrimLeft(lines[i][len(match[0]):], " \t"))
+			endLine, endCol, err := tomlValueEnd(lines, i, col)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			values[tomlJoin(table, tomlKeyPath(match[1]))] = tomlValue{table: table, line: i, endLine: endLine, endCol: endCol}
+			if table != "" {
+				tables[table] = endLine
+			}
+			i = endLine
+		}
+	}
+
+	replaced := make(map[int]string)
+	removed := make(map[int]bool)
+	replace := func(value tomlValue, text string) {
+		key := tomlKeyPattern.FindString(lines[value.line])
+		replaced[value.line] = key + " " + tomlString(text) + lines[value.endLine][value.endCol:]
+		for i := value.line + 1; i <= value.endLine; i++ {
+			removed[i] = true
+		}
+	}
+	inserted := make(map[int][]string)
+	insert := func(after int, table, path, text string) {
+		key := strings.TrimPrefix(path, table+".")
+		inserted[after] = append(inserted[after], tomlKey(key)+" = "+tomlString(text))
+	}
+
+	var topLevel, appended []string
+	for _, message := range messages {
+		if !isPluralMessage(message) {
+			if value, ok := values[message.ID]; ok {
+				replace(value, message.Other)
+			} else if value, ok := values[message.ID+".other"]; ok {
+				replace(value, message.Other)
+			} else if end, ok := tables[message.ID]; ok {
+				insert(end, message.ID, message.ID+".other", message.Other)
+			} else if table, ok := tomlParentTable(tables, message.ID); ok {
+				insert(tables[table], table, message.ID, message.Other)
+			} else {
+				topLevel = append(topLevel, tomlKey(message.ID)+" = "+tomlString(message.Other))
+			}
+			continue
+		}
+
+		// Missing plural forms go next to the forms the file already has, or into the deepest table
+		// containing the message, so they never redefine a table with dotted keys.
+		var missing [][2]string
+		last, hasForm := tomlValue{}, false
+		for _, field := range messageFields(message) {
+			if value, ok := values[message.ID+"."+field[0]]; ok {
+				replace(value, field[1])
+				last, hasForm = value, true
+			} else {
+				missing = append(missing, field)
+			}
+		}
+		var newTable []string
+		for _, field := range missing {
+			path := message.ID + "." + field[0]
+			if hasForm {
+				insert(last.endLine, last.table, path, field[1])
+			} else if table, ok := tomlParentTable(tables, path); ok {
+				insert(tables[table], table, path, field[1])
+			} else {
+				newTable = append(newTable, field[0]+" = "+tomlString(field[1]))
+			}
+		}
+		if len(newTable) > 0 {
+			appended = append(appended, "", "["+tomlKey(message.ID)+"]")
+			appended = append(appended, newTable...)
+		}
+	}
+
+	emit := func(from, to int) []string {
+		var result []string
+		for i := from; i < to; i++ {
+			if removed[i] {
+				continue
+			}
+			if line, ok := replaced[i]; ok {
+				result = append(result, line)
+			} else {
+				result = append(result, lines[i])
+			}
+			result = append(result, inserted[i]...)
+		}
+		return result
+	}
+
+	result := emit(0, firstTable)
+	if len(topLevel) > 0 {
+		for len(result) > 0 && strings.TrimSpace(result[len(result)-1]) == "" {
+			result = result[:len(result)-1]
+		}
+		result = append(result, topLevel...)
+		if firstTable < len(lines) {
+			result = append(result, "")
+		}
+	}
+	result = append(result, emit(firstTable, len(lines))...)
+	result = append(result, appended...)
+	return []byte(strings.Join(result, "\n") + "\n"), nil
+}
+
+// tomlParentTable returns the deepest table of the file containing the key path.
+func tomlParentTable(tables map[string]int, path string) (string, bool) {
+	for {
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return "", false
+		}
+		path = path[:i]
+		if _, ok := tables[path]; ok {
+			return path, true
+		}
+	}
+}
+
+// tomlValueEnd returns the line and the column after the end of the value starting at lines[i][col],
+// so multiline strings are replaced as a whole and the comment after the value is kept.
+func tomlValueEnd(lines []string, i, col int) (int, int, error) {
+	line := lines[i]
+	for _, delim := range []string{`"""`, `'''`} {
+		if !strings.HasPrefix(line[col:], delim) {
+			continue
+		}
+		for j, start := i, col+len(delim); j < len(lines); j, start = j+1, 0 {
+			if end := tomlStringEnd(lines[j][start:], delim); end >= 0 {
+				return j, start + end, nil
+			}
+		}
+		return 0, 0, errors.New("unterminated multiline string")
+	}
+	switch {
+	case strings.HasPrefix(line[col:], `"`):
+		if end := tomlStringEnd(line[col+1:], `"`); end >= 0 {
+			return i, col + 1 + end, nil
+		}
+		return 0, 0, errors.New("unterminated string")
+	case strings.HasPrefix(line[col:], "'"):
+		if end := strings.IndexByte(line[col+1:], '\''); end >= 0 {
+			return i, col + 2 + end, nil
+		}
+		return 0, 0, errors.New("unterminated string")
+	}
+	if end := strings.IndexByte(line[col:], '#'); end >= 0 {
+		return i, col + len(strings.TrimRight(line[col:col+end], " \t")), nil
+	}
+	return i, len(line), nil
+}
+
+// tomlStringEnd returns the index after the delimiter closing the string in s, or -1 if the string
+// doesn't end in s. Basic strings skip escaped characters, and up to two quotes may precede the
+// closing delimiter of a multiline string.
+func tomlStringEnd(s, delim string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && delim[0] == '"' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], delim) {
+			end := i + len(delim)
+			for n := 0; n < 2 && len(delim) == 3 && end < len(s) && s[end] == delim[0]; n++ {
+				end++
+			}
+			return end
+		}
+	}
+	return -1
+}
+
+func tomlKeyPath(key string) string {
+	var parts []string
+	for _, part := range splitTOMLKey(key) {
+		part = strings.TrimSpace(part)
+		if unquoted, err := strconv.Unquote(part); err == nil {
+			part = unquoted
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// splitTOMLKey splits a dotted TOML key on the dots outside of quoted parts.
+func splitTOMLKey(key string) []string {
+	var (
+		parts  []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(key); i++ {
+		switch {
+		case key[i] == '\\' && quoted:
+			i++
+		case key[i] == '"':
+			quoted = !quoted
+		case key[i] == '.' && !quoted:
+			parts = append(parts, key[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, key[start:])
+}
+
+func tomlJoin(table, key string) string {
+	if table == "" {
+		return key
+	}
+	return table + "." + key
+}
+
+func tomlKey(id string) string {
+	parts := strings.Split(id, ".")
+	for _, part := range parts {
+		if !tomlBareKey.MatchString(part) {
+			return tomlString(id)
+		}
+	}
+	return id
+}
+
+func tomlReplaceValue(line, value string) string {
+	match := tomlKeyPattern.FindString(line)
+	return match + " " + tomlString(value)
+}
+
+func tomlString(value string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
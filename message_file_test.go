@@ -0,0 +1,122 @@
+package i18n_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMessageFileTOML(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		messages []*i18n.Message
+		expected string
+	}{
+		{
+			name: "new key in existing table",
+			content: `welcome = "Halo"
+
+[auth]
+login = "Masuk"
+
+[auth.password]
+reset = "Atur ulang"
+`,
+			messages: []*i18n.Message{
+				{ID: "auth.logout", Other: "Keluar"},
+				{ID: "auth.password.forgot", Other: "Lupa kata sandi"},
+				{ID: "auth.items", One: "Satu barang", Other: "{{.Count}} barang"},
+			},
+			expected: `welcome = "Halo"
+
+[auth]
+login = "Masuk"
+logout = "Keluar"
+items.one = "Satu barang"
+items.other = "{{.Count}} barang"
+
+[auth.password]
+reset = "Atur ulang"
+forgot = "Lupa kata sandi"
+`,
+		},
+		{
+			name: "new plural form next to existing forms",
+			content: `items.other = "{{.Count}} barang"
+welcome = "Halo"
+`,
+			messages: []*i18n.Message{
+				{ID: "items", One: "Satu barang", Other: "{{.Count}} barang"},
+			},
+			expected: `items.other = "{{.Count}} barang"
+items.one = "Satu barang"
+welcome = "Halo"
+`,
+		},
+		{
+			name: "trailing comments",
+			content: `welcome = "Halo" # shown on the home page
+
+[auth]
+login = 'Login'   # button
+logout = "Keluar \"sekarang\"" # link
+`,
+			messages: []*i18n.Message{
+				{ID: "welcome", Other: "Selamat datang"},
+				{ID: "auth.login", Other: "Masuk"},
+				{ID: "auth.logout", Other: "Keluar"},
+			},
+			expected: `welcome = "Selamat datang" # shown on the home page
+
+[auth]
+login = "Masuk"   # button
+logout = "Keluar" # link
+`,
+		},
+		{
+			name: "multiline values",
+			content: `intro = """
+Halo,
+ini [bukan] tabel = "nilai"
+""" # comment
+terms = '''
+Syarat'''
+footer = "Tetap"
+`,
+			messages: []*i18n.Message{
+				{ID: "intro", Other: "Halo,\nselamat datang"},
+				{ID: "terms", Other: "Syarat dan ketentuan"},
+			},
+			expected: `intro = "Halo,\nselamat datang" # comment
+terms = "Syarat dan ketentuan"
+footer = "Tetap"
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "id.toml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o644))
+
+			require.NoError(t, i18n.UpdateMessageFile(path, tc.messages...))
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+		})
+	}
+
+	t.Run("unterminated multiline value", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "id.toml")
+		require.NoError(t, os.WriteFile(path, []byte("intro = \"\"\"\nHalo\n"), 0o644))
+
+		err := i18n.UpdateMessageFile(path, &i18n.Message{ID: "intro", Other: "Halo"})
+		assert.EqualError(t, err, path+": line 1: unterminated multiline string")
+	})
+}
@@ -0,0 +1,96 @@
+package i18n
+
+import (
+	"slices"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// Message is a message with its plural forms, the message type of github.com/nicksnyder/go-i18n/v2/i18n.
+//
+// Example:
+//
+//	message := &i18n.Message{ID: "items", One: "{{.Count}} item", Other: "{{.Count}} items"}
+type Message = i18n.Message
+
+// MessageInfo describes a loaded message.
+type MessageInfo struct {
+	// ID is the message ID, including the namespace and the variant.
+	ID string
+	// Description is the description of the message for translators.
+	Description string
+	// PluralForms are the plural forms the message defines, e.g. ["one", "other"], in CLDR order.
+	PluralForms []string
+	// Path is the file the message was loaded from, empty for messages added with AddMessages.
+	Path string
+}
+
+// Languages returns the loaded languages, the default language first.
+//
+// Example:
+//
+//	for _, tag := range i18n.Languages() {
+//		fmt.Println(tag) // en, id
+//	}
+func Languages() []language.Tag {
+	if bundle == nil {
+		return nil
+	}
+	return slices.Clone(bundle.LanguageTags())
+}
+
+// Messages returns the messages loaded or added with AddMessages for the language, sorted by ID.
+//
+// The language must be one of Languages, other languages have no messages.
+//
+// Example:
+//
+//	for _, message := range i18n.Messages(language.Indonesian) {
+//		fmt.Println(message.ID, message.Path)
+//	}
+func Messages(tag language.Tag) []MessageInfo {
+	overrides := currentRuntimeMessages()
+	ids := messageIDs(overrides, tag)
+	messages := make([]MessageInfo, 0, len(ids))
+	for _, id := range ids {
+		var message *i18n.Message
+		var path string
+		if overrides.has(tag, id) {
+			message = overrides.messages[tag][id]
+		} else {
+			entry := catalog[tag][id]
+			message, path = entry.message, entry.path
+		}
+		messages = append(messages, MessageInfo{
+			ID:          id,
+			Description: message.Description,
+			PluralForms: messagePluralForms(message),
+			Path:        path,
+		})
+	}
+	return messages
+}
+
+// HasMessage reports whether the message is loaded for the language, without falling back to other languages.
+//
+// Example:
+//
+//	if !i18n.HasMessage(language.Indonesian, "settings.title") {
+//		// not translated yet
+//	}
+func HasMessage(tag language.Tag, id string) bool {
+	return hasMessage(tag, id)
+}
+
+// messagePluralForms returns the names of the plural forms the message defines.
+func messagePluralForms(message *i18n.Message) []string {
+	var forms []string
+	for _, form := range []plural.Form{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other} {
+		if getPluralForm(message, form) != "" {
+			forms = append(forms, pluralFormNames[form])
+		}
+	}
+	return forms
+}
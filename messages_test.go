@@ -0,0 +1,90 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLanguages(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	assert.Nil(t, i18n.Languages())
+
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/id.yaml", "testdata/en.yaml"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []language.Tag{language.English, language.Indonesian}, i18n.Languages())
+}
+
+func TestMessages(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithNestedKeys("."),
+		i18n.WithTranslationFile("testdata/nested.en.yaml", "testdata/nested.id.json"),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		tag      language.Tag
+		expected []i18n.MessageInfo
+	}{
+		{
+			name: "default language",
+			tag:  language.English,
+			expected: []i18n.MessageInfo{
+				{ID: "auth.items", PluralForms: []string{"one", "other"}, Path: "testdata/nested.en.yaml"},
+				{ID: "auth.login.button", Description: "Button of the login form", PluralForms: []string{"other"}, Path: "testdata/nested.en.yaml"},
+				{ID: "auth.login.description", PluralForms: []string{"other"}, Path: "testdata/nested.en.yaml"},
+				{ID: "auth.login.title", PluralForms: []string{"other"}, Path: "testdata/nested.en.yaml"},
+				{ID: "home", PluralForms: []string{"other"}, Path: "testdata/nested.en.yaml"},
+			},
+		},
+		{
+			name: "translated language",
+			tag:  language.Indonesian,
+			expected: []i18n.MessageInfo{
+				{ID: "auth.login.title", PluralForms: []string{"other"}, Path: "testdata/nested.id.json"},
+			},
+		},
+		{
+			name:     "language not loaded",
+			tag:      language.French,
+			expected: []i18n.MessageInfo{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, i18n.Messages(tc.tag))
+		})
+	}
+}
+
+func TestHasMessage(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name     string
+		tag      language.Tag
+		id       string
+		expected bool
+	}{
+		{name: "default language", tag: language.English, id: "hello_english", expected: true},
+		{name: "translated", tag: language.Indonesian, id: "hello", expected: true},
+		{name: "not translated", tag: language.Indonesian, id: "hello_english", expected: false},
+		{name: "unknown message", tag: language.English, id: "unknown", expected: false},
+		{name: "language not loaded", tag: language.French, id: "hello", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, i18n.HasMessage(tc.tag, tc.id))
+		})
+	}
+}
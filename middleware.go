@@ -11,29 +11,42 @@ type contextKey string
 
 const languageCtxKey contextKey = "i18n-language"
 
-func defaultLanguageHandler(headerKey string) func(r *http.Request) string {
+func defaultLanguageHandler(headerKey, queryKey string) func(r *http.Request) string {
 	return func(r *http.Request) string {
+		if queryKey != "" {
+			if lang := r.URL.Query().Get(queryKey); lang != "" {
+				return lang
+			}
+		}
 		return r.Header.Get(headerKey)
 	}
 }
 
 // NewMiddleware creates a middleware that sets the language to the context from the request.
 //
-// It uses the Accept-Language header to get the language.
+// It uses the Accept-Language header to get the language, or the query parameter set with WithQueryKey,
+// and adds the Localizer of the language to the context for FromContext.
 func NewMiddleware(opts ...MiddlewareOption) func(http.Handler) http.Handler {
 	cfg := newMiddlewareConfig(opts...)
 	if cfg.langHandler == nil {
-		cfg.langHandler = defaultLanguageHandler(cfg.headerKey)
+		cfg.langHandler = defaultLanguageHandler(cfg.headerKey, cfg.queryKey)
 	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			lang := cfg.langHandler(r)
-			if lang != "" {
-				ctx := SetLangToContext(r.Context(), lang)
-				r = r.WithContext(ctx)
+			ctx := r.Context()
+			if lang := cfg.langHandler(r); lang != "" {
+				ctx = SetLangToContext(ctx, lang)
+			}
+			if cfg.tenantHandler != nil {
+				if tenant := cfg.tenantHandler(r); tenant != "" {
+					ctx = SetTenantToContext(ctx, tenant)
+				}
+			}
+			if cfg.direction {
+				ctx = withDirection(ctx)
 			}
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(w, r.WithContext(withLocalizer(ctx)))
 		})
 	}
 }
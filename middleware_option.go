@@ -3,8 +3,11 @@ package i18n
 import "net/http"
 
 type middlewareConfig struct {
-	headerKey   string
-	langHandler func(r *http.Request) string
+	headerKey     string
+	queryKey      string
+	langHandler   func(r *http.Request) string
+	direction     bool
+	tenantHandler func(r *http.Request) string
 }
 
 const defaultHeaderKey = "Accept-Language"
@@ -38,3 +41,29 @@ func WithLanguageHandler(handler func(r *http.Request) string) MiddlewareOption
 		cfg.langHandler = handler
 	}
 }
+
+// WithQueryKey sets the query parameter that overrides the language header, e.g. "lang" for ?lang=en-XA.
+func WithQueryKey(key string) MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.queryKey = key
+	}
+}
+
+// WithDirection adds the text direction of the language to the context, for Direction.
+func WithDirection() MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.direction = true
+	}
+}
+
+// WithTenantHandler sets the handler that returns the tenant of the request, which is set to the context
+// so lookups use the message overrides of the tenant.
+//
+// Example:
+//
+//	i18n.WithTenantHandler(func(r *http.Request) string { return r.Header.Get("X-Tenant-ID") })
+func WithTenantHandler(handler func(r *http.Request) string) MiddlewareOption {
+	return func(cfg *middlewareConfig) {
+		cfg.tenantHandler = handler
+	}
+}
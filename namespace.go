@@ -0,0 +1,85 @@
+package i18n
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+// namespaceSeparator separates the namespace from the message ID, e.g. "billing:invoice.title".
+const namespaceSeparator = ":"
+
+var namespaceFallback string
+
+func namespacedID(namespace, id string) string {
+	return namespace + namespaceSeparator + id
+}
+
+// resolveNamespacedID returns the ID of the message to look up for id in namespace.
+//
+// The namespace is checked before the fallback namespace, first in the language matched
+// for languages and then in the default language.
+func resolveNamespacedID(languages []string, namespace, id string) string {
+	candidates := []string{namespacedID(namespace, id)}
+	if namespaceFallback != "" && namespaceFallback != namespace {
+		candidates = append(candidates, namespacedID(namespaceFallback, id))
+	}
+	for _, tag := range []language.Tag{matchLanguage(parseLanguages(languages)...), defaultLanguage} {
+		for _, candidate := range candidates {
+			if hasMessage(tag, candidate) {
+				return candidate
+			}
+		}
+	}
+	return candidates[0]
+}
+
+// Namespace sets the namespace of the message.
+//
+// Example:
+//
+//	i18n.T("invoice.title", i18n.Namespace("billing"))
+func Namespace(namespace string) LocalizeOption {
+	return func(c *localizeConfig) {
+		c.namespace = namespace
+	}
+}
+
+// Translator translates messages of a namespace.
+type Translator struct {
+	namespace string
+}
+
+// NewTranslator returns a Translator for the namespace.
+//
+// Example:
+//
+//	billing := i18n.NewTranslator("billing")
+//	message := billing.TCtx(ctx, "invoice.title")
+func NewTranslator(namespace string) *Translator {
+	return &Translator{namespace: namespace}
+}
+
+// Get returns the translated message of the namespace for the given message id.
+//
+// It is similar to i18n.Get.
+func (t *Translator) Get(id string, opts ...any) string {
+	return t.GetCtx(context.Background(), id, opts...)
+}
+
+// GetCtx returns the translated message of the namespace for the given message id.
+//
+// It is similar to i18n.GetCtx.
+func (t *Translator) GetCtx(ctx context.Context, id string, opts ...any) string {
+	return GetCtx(ctx, id, append([]any{Namespace(t.namespace)}, opts...)...)
+}
+
+// T is an alias for Get.
+func (t *Translator) T(id string, opts ...any) string {
+	return t.Get(id, opts...)
+}
+
+// TCtx is an alias for GetCtx.
+func (t *Translator) TCtx(ctx context.Context, id string, opts ...any) string {
+	return t.GetCtx(ctx, id, opts...)
+}
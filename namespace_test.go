@@ -0,0 +1,123 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/afkdevs/go-i18n/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNamespace(t *testing.T) {
+	initI18n(t,
+		i18n.WithNamespaceFile("billing", "testdata/billing.en.yaml", "testdata/billing.id.yaml"),
+		i18n.WithNamespaceFile("auth", "testdata/auth.en.yaml"),
+		i18n.WithNamespaceFile("common", "testdata/common.en.yaml", "testdata/common.id.yaml"),
+		i18n.WithNamespaceFallback("common"),
+	)
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		language        string
+		expectedMessage string
+	}{
+		{
+			name:            "namespace",
+			messageID:       "title",
+			options:         []any{i18n.Namespace("billing")},
+			expectedMessage: "Billing",
+		},
+		{
+			name:            "another namespace with the same key",
+			messageID:       "title",
+			options:         []any{i18n.Namespace("auth")},
+			expectedMessage: "Authentication",
+		},
+		{
+			name:            "namespace with language",
+			messageID:       "title",
+			options:         []any{i18n.Namespace("billing")},
+			language:        "id",
+			expectedMessage: "Tagihan",
+		},
+		{
+			name:            "namespace with params",
+			messageID:       "invoice",
+			options:         []any{i18n.Namespace("billing"), i18n.Param("number", 42)},
+			expectedMessage: "Invoice #42",
+		},
+		{
+			name:            "fallback namespace",
+			messageID:       "save",
+			options:         []any{i18n.Namespace("billing")},
+			expectedMessage: "Save",
+		},
+		{
+			name:            "fallback namespace in the requested language",
+			messageID:       "save",
+			options:         []any{i18n.Namespace("billing")},
+			language:        "id",
+			expectedMessage: "Simpan",
+		},
+		{
+			name:            "namespace falls back to the default language",
+			messageID:       "invoice",
+			options:         []any{i18n.Namespace("billing"), i18n.Param("number", 1)},
+			language:        "id",
+			expectedMessage: "Invoice #1",
+		},
+		{
+			name:            "without namespace",
+			messageID:       "test",
+			expectedMessage: "This is test message",
+		},
+		{
+			name:            "namespaced messages are not global",
+			messageID:       "save",
+			expectedMessage: "ERROR: missing translation for \"save\"",
+		},
+		{
+			name:            "not found in namespace",
+			messageID:       "test",
+			options:         []any{i18n.Namespace("billing")},
+			expectedMessage: "ERROR: missing translation for \"billing:test\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			message := i18n.TCtx(ctx, tc.messageID, tc.options...)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestTranslator(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithNamespaceFile("billing", "testdata/billing.en.yaml", "testdata/billing.id.yaml"),
+		i18n.WithNamespaceFSFile("app", testdata.FS, "en.yaml", "id.yaml"),
+	)
+	require.NoError(t, err)
+
+	billing := i18n.NewTranslator("billing")
+	assert.Equal(t, "Billing", billing.T("title"))
+	assert.Equal(t, "Billing", billing.Get("title"))
+	assert.Equal(t, "Tagihan", billing.TCtx(i18n.SetLangToContext(context.Background(), "id"), "title"))
+	assert.Equal(t, "Tagihan", billing.GetCtx(context.Background(), "title", i18n.Lang("id")))
+
+	app := i18n.NewTranslator("app")
+	assert.Equal(t, "Hello, John", app.T("hello_name", i18n.Param("name", "John")))
+	assert.Equal(t, "ERROR: missing translation for \"app:save\"", app.T("save"))
+}
@@ -0,0 +1,149 @@
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// parseNestedMessageFile parses a message file, flattening nested maps into message IDs joined by separator.
+func parseNestedMessageFile(data []byte, path string, unmarshalFuncs map[string]i18n.UnmarshalFunc, separator string) (*i18n.MessageFile, error) {
+	file, raw, err := unmarshalMessageFile(data, path, unmarshalFuncs)
+	if err != nil {
+		return nil, err
+	}
+	file.Messages, err = flattenMessages("", raw, separator)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// unmarshalMessageFile returns the message file without messages and the unmarshaled data of the file.
+func unmarshalMessageFile(data []byte, path string, unmarshalFuncs map[string]i18n.UnmarshalFunc) (*i18n.MessageFile, any, error) {
+	// ParseMessageFileBytes only resolves the language and format from the path when buf is empty.
+	file, err := i18n.ParseMessageFileBytes(nil, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 {
+		return file, nil, nil
+	}
+
+	unmarshalFunc := unmarshalFuncs[file.Format]
+	if unmarshalFunc == nil {
+		if file.Format != "json" {
+			return nil, nil, fmt.Errorf("no unmarshaler registered for %s", file.Format)
+		}
+		unmarshalFunc = json.Unmarshal
+	}
+	var raw any
+	if err := unmarshalFunc(data, &raw); err != nil {
+		return nil, nil, err
+	}
+	return file, raw, nil
+}
+
+// flattenMessages returns the messages in raw with their IDs prefixed by the keys leading to them.
+//
+// A map is a message if all of its keys are message fields (one, other, description, ...) with string values,
+// or if it defines message variants with a "_select" key. Any other map is a level of the hierarchy.
+func flattenMessages(prefix string, raw any, separator string) ([]*i18n.Message, error) {
+	switch data := raw.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if prefix == "" {
+			return nil, errInvalidMessageFile
+		}
+		return []*i18n.Message{{ID: prefix, Other: data}}, nil
+	case []any:
+		// Backward compatibility for the v1 file format, a list of messages with explicit IDs.
+		var messages []*i18n.Message
+		for _, item := range data {
+			message, err := i18n.NewMessage(item)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
+		return messages, nil
+	}
+
+	children, err := stringKeyMap(raw)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && isVariantMap(children) {
+		return variantMessages(prefix, children)
+	}
+	if prefix != "" && isMessageMap(children) {
+		message, err := i18n.NewMessage(children)
+		if err != nil {
+			return nil, err
+		}
+		message.ID = prefix
+		return []*i18n.Message{message}, nil
+	}
+
+	keys := make([]string, 0, len(children))
+	for key := range children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var messages []*i18n.Message
+	for _, key := range keys {
+		id := key
+		if prefix != "" {
+			id = prefix + separator + key
+		}
+		childMessages, err := flattenMessages(id, children[key], separator)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, childMessages...)
+	}
+	return messages, nil
+}
+
+var errInvalidMessageFile = errors.New("invalid translation file, expected key-values, got a single value")
+
+// stringKeyMap converts the maps produced by the unmarshal functions into map[string]any.
+func stringKeyMap(raw any) (map[string]any, error) {
+	switch data := raw.(type) {
+	case map[string]any:
+		return data, nil
+	case map[any]any:
+		result := make(map[string]any, len(data))
+		for key, value := range data {
+			stringKey, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected key to be string but got %#v", key)
+			}
+			result[stringKey] = value
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", raw)
+	}
+}
+
+func isMessageMap(data map[string]any) bool {
+	if len(data) == 0 {
+		return false
+	}
+	for key, value := range data {
+		if _, ok := messageObjectKeys[strings.ToLower(key)]; !ok {
+			return false
+		}
+		if _, ok := value.(string); !ok && strings.ToLower(key) != "translation" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package i18n_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/afkdevs/go-i18n/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNestedKeys(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+
+	testCases := []struct {
+		name            string
+		separator       string
+		messageID       string
+		options         []any
+		expectedMessage string
+	}{
+		{
+			name:            "nested message",
+			separator:       ".",
+			messageID:       "auth.login.title",
+			expectedMessage: "Log in",
+		},
+		{
+			name:            "metadata key next to other keys is a message",
+			separator:       ".",
+			messageID:       "auth.login.description",
+			expectedMessage: "Sign in with your account",
+		},
+		{
+			name:            "message object with description",
+			separator:       ".",
+			messageID:       "auth.login.button",
+			expectedMessage: "Continue",
+		},
+		{
+			name:            "plural message object",
+			separator:       ".",
+			messageID:       "auth.items",
+			options:         []any{i18n.Count(1)},
+			expectedMessage: "1 item",
+		},
+		{
+			name:            "top level message",
+			separator:       ".",
+			messageID:       "home",
+			expectedMessage: "Home",
+		},
+		{
+			name:            "nested message in json",
+			separator:       ".",
+			messageID:       "auth.login.title",
+			options:         []any{i18n.Lang("id")},
+			expectedMessage: "Masuk",
+		},
+		{
+			name:            "custom separator",
+			separator:       "/",
+			messageID:       "auth/login/title",
+			expectedMessage: "Log in",
+		},
+		{
+			name:            "default separator",
+			separator:       "",
+			messageID:       "auth.login.title",
+			expectedMessage: "Log in",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := i18n.Init(language.English,
+				i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+				i18n.WithNestedKeys(tc.separator),
+				i18n.WithTranslationFile("testdata/nested.en.yaml", "testdata/nested.id.json"),
+			)
+			require.NoError(t, err)
+
+			message := i18n.T(tc.messageID, tc.options...)
+			assert.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
+
+func TestNestedKeysInit(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+
+	t.Run("without nested keys", func(t *testing.T) {
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithTranslationFile("testdata/nested.en.yaml"),
+		)
+		assert.Error(t, err)
+	})
+	t.Run("with FS", func(t *testing.T) {
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithNestedKeys("."),
+			i18n.WithTranslationFSFile(testdata.FS, "en.yaml", "id.yaml"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "Ini adalah pesan tes", i18n.T("test", i18n.Lang("id")))
+	})
+	t.Run("when file not found", func(t *testing.T) {
+		err := i18n.Init(language.English, i18n.WithNestedKeys("."), i18n.WithTranslationFile("testdata/nested.es.yaml"))
+		assert.Error(t, err)
+	})
+	t.Run("when unmarshal func not registered", func(t *testing.T) {
+		err := i18n.Init(language.English, i18n.WithNestedKeys("."), i18n.WithTranslationFile("testdata/nested.en.yaml"))
+		assert.Error(t, err)
+	})
+	t.Run("when file is a single value", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "en.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("hello\n"), 0o644))
+		err := i18n.Init(language.English,
+			i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+			i18n.WithNestedKeys("."),
+			i18n.WithTranslationFile(path),
+		)
+		assert.Error(t, err)
+	})
+}
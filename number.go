@@ -0,0 +1,126 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"unicode"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// FormatNumber formats the number with the grouping and decimal separators of the context language.
+//
+// Example:
+//
+//	i18n.FormatNumber(ctx, 1234.5) // 1,234.5 in English, 1.234,5 in Indonesian
+func FormatNumber(ctx context.Context, value any) string {
+	return formatNumber(contextLanguage(ctx), value)
+}
+
+// FormatPercent formats the ratio as a percentage in the context language.
+//
+// Example:
+//
+//	i18n.FormatPercent(ctx, 0.25) // 25% in English, 25 % in German
+func FormatPercent(ctx context.Context, value any) string {
+	return formatPercent(contextLanguage(ctx), value)
+}
+
+// FormatCurrency formats the amount of the ISO 4217 currency in the context language,
+// with the currency symbol, placement and decimals of the language.
+//
+// Example:
+//
+//	i18n.FormatCurrency(ctx, 1234.5, "IDR") // Rp 1.234,50 in Indonesian, with a no-break space
+//	i18n.FormatCurrency(ctx, 1234.5, "USD") // $1,234.50 in English
+func FormatCurrency(ctx context.Context, amount any, code string) string {
+	return formatCurrency(contextLanguage(ctx), amount, code)
+}
+
+func formatNumber(tag language.Tag, value any) string {
+	n, ok := numberValue(value)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	return message.NewPrinter(tag).Sprint(number.Decimal(n))
+}
+
+func formatPercent(tag language.Tag, value any) string {
+	n, ok := numberValue(value)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	return message.NewPrinter(tag).Sprint(number.Percent(n))
+}
+
+// numberValue returns the value to format for a number, numeric strings parsed as float64
+// since golang.org/x/text/number formats strings as NaN. Other numbers are kept as they are,
+// so large integers keep their precision.
+func numberValue(value any) (any, bool) {
+	f, ok := toFloat(value)
+	if !ok {
+		return nil, false
+	}
+	if reflect.ValueOf(value).Kind() == reflect.String {
+		return f, true
+	}
+	return value, true
+}
+
+// currencyDigits overrides the decimals of currencies where golang.org/x/text uses the cash digits
+// instead of the CLDR standard digits.
+var currencyDigits = map[string]int{
+	"IDR": 2,
+}
+
+// currencySymbolAfterLanguages are the languages that put the currency symbol after the amount.
+var currencySymbolAfterLanguages = map[string]bool{
+	"ar": true, "bg": true, "ca": true, "cs": true, "da": true, "de": true, "el": true, "es": true,
+	"et": true, "fa": true, "fi": true, "fr": true, "hr": true, "hu": true, "it": true, "lt": true,
+	"lv": true, "nb": true, "no": true, "pl": true, "ro": true, "ru": true, "sk": true, "sv": true,
+	"uk": true, "vi": true,
+}
+
+func formatCurrency(tag language.Tag, amount any, code string) string {
+	value, ok := toFloat(amount)
+	if !ok {
+		return fmt.Sprint(amount)
+	}
+	printer := message.NewPrinter(tag)
+
+	symbol, digits := code, 2
+	if unit, err := currency.ParseISO(code); err == nil {
+		symbol = printer.Sprint(currency.Symbol(unit))
+		digits, _ = currency.Standard.Rounding(unit)
+		if d, ok := currencyDigits[unit.String()]; ok {
+			digits = d
+		}
+	}
+	formatted := printer.Sprint(number.Decimal(math.Abs(value), number.Scale(digits)))
+
+	base, _ := tag.Base()
+	switch {
+	case currencySymbolAfterLanguages[base.String()]:
+		formatted = formatted + "\u00a0" + symbol
+	case needsCurrencySpacing(symbol):
+		formatted = symbol + "\u00a0" + formatted
+	default:
+		formatted = symbol + formatted
+	}
+	if value < 0 {
+		formatted = "-" + formatted
+	}
+	return formatted
+}
+
+// needsCurrencySpacing reports whether a no-break space separates the symbol from a following amount,
+// which CLDR does for symbols ending with a letter, e.g. "Rp 1.234,50" but "$1,234.50".
+func needsCurrencySpacing(symbol string) bool {
+	runes := []rune(symbol)
+	return len(runes) > 0 && unicode.IsLetter(runes[len(runes)-1])
+}
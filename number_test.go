@@ -0,0 +1,174 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestFormatNumber(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		language string
+		format   func(ctx context.Context) string
+		expected string
+	}{
+		{
+			name:     "number",
+			format:   func(ctx context.Context) string { return i18n.FormatNumber(ctx, 1234.5) },
+			expected: "1,234.5",
+		},
+		{
+			name:     "number in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatNumber(ctx, 1234.5) },
+			expected: "1.234,5",
+		},
+		{
+			name:     "number in indian english",
+			language: "en-IN",
+			format:   func(ctx context.Context) string { return i18n.FormatNumber(ctx, 1234567) },
+			expected: "12,34,567",
+		},
+		{
+			name:     "not a number",
+			format:   func(ctx context.Context) string { return i18n.FormatNumber(ctx, "abc") },
+			expected: "abc",
+		},
+		{
+			name:     "numeric string",
+			format:   func(ctx context.Context) string { return i18n.FormatNumber(ctx, "1234.5") },
+			expected: "1,234.5",
+		},
+		{
+			name:     "numeric string percent",
+			format:   func(ctx context.Context) string { return i18n.FormatPercent(ctx, "0.25") },
+			expected: "25%",
+		},
+		{
+			name:     "percent",
+			format:   func(ctx context.Context) string { return i18n.FormatPercent(ctx, 0.25) },
+			expected: "25%",
+		},
+		{
+			name:     "percent in german",
+			language: "de",
+			format:   func(ctx context.Context) string { return i18n.FormatPercent(ctx, 0.25) },
+			expected: "25\u00a0%",
+		},
+		{
+			name:     "currency",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, 1234.5, "USD") },
+			expected: "$1,234.50",
+		},
+		{
+			name:     "currency in indonesian",
+			language: "id",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, 1234.5, "IDR") },
+			expected: "Rp\u00a01.234,50",
+		},
+		{
+			name:     "currency after the amount",
+			language: "de",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, 1234.5, "EUR") },
+			expected: "1.234,50\u00a0€",
+		},
+		{
+			name:     "currency without decimals",
+			language: "ja",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, 1234, "JPY") },
+			expected: "￥1,234",
+		},
+		{
+			name:     "negative currency",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, -5, "USD") },
+			expected: "-$5.00",
+		},
+		{
+			name:     "unknown currency",
+			format:   func(ctx context.Context) string { return i18n.FormatCurrency(ctx, 5, "points") },
+			expected: "points\u00a05.00",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expected, tc.format(ctx))
+		})
+	}
+}
+
+func TestNumberTemplateFuncs(t *testing.T) {
+	initI18n(t)
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		language        string
+		expectedMessage string
+	}{
+		{
+			name:            "currency",
+			messageID:       "total",
+			options:         []any{i18n.Param("amount", 1234.5)},
+			expectedMessage: "Total: $1,234.50",
+		},
+		{
+			name:            "currency in indonesian",
+			messageID:       "total",
+			options:         []any{i18n.Param("amount", 1234.5)},
+			language:        "id",
+			expectedMessage: "Total: Rp\u00a01.234,50",
+		},
+		{
+			name:            "number in indonesian",
+			messageID:       "item_count",
+			options:         []any{i18n.Param("count", 1200)},
+			language:        "id",
+			expectedMessage: "1.200 barang",
+		},
+		{
+			name:            "percent",
+			messageID:       "progress",
+			options:         []any{i18n.Param("ratio", 0.5)},
+			expectedMessage: "50% done",
+		},
+		{
+			name:            "fallback message uses the context language",
+			messageID:       "progress",
+			options:         []any{i18n.Param("ratio", 0.5)},
+			language:        "de",
+			expectedMessage: "50\u00a0% done",
+		},
+		{
+			name:            "number in a fallback message",
+			messageID:       "item_count",
+			options:         []any{i18n.Param("count", 1234.5)},
+			language:        "fr",
+			expectedMessage: "1\u00a0234,5 items",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expectedMessage, i18n.GetCtx(ctx, tc.messageID, tc.options...))
+		})
+	}
+}
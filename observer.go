@@ -0,0 +1,38 @@
+package i18n
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// LookupEvent describes a message lookup of GetCtx.
+type LookupEvent struct {
+	// ID is the message ID, including the namespace and the variant.
+	ID string
+	// Languages are the requested languages, from the Lang option and the context.
+	Languages []language.Tag
+	// Matched is the loaded language matched for the requested languages, the default language if none
+	// of them is loaded. Unlike Languages it is bounded by the loaded languages, e.g. for metric attributes.
+	Matched language.Tag
+	// Language is the language the message was served in, or language.Und if it is missing.
+	Language language.Tag
+	// Fallback reports whether the message was served in a fallback language, because it is missing
+	// in the language matched for the requested languages or none of the requested languages is loaded.
+	Fallback bool
+	// Missing reports whether the message wasn't found in any language.
+	Missing bool
+	// Duration is the duration of the lookup.
+	Duration time.Duration
+}
+
+// LookupObserver observes message lookups.
+//
+// It is called synchronously after every lookup, so implementations should be fast
+// and must be safe for concurrent use.
+type LookupObserver interface {
+	ObserveLookup(ctx context.Context, event LookupEvent)
+}
+
+var lookupObserver LookupObserver
@@ -0,0 +1,76 @@
+package i18n_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []i18n.LookupEvent
+}
+
+func (o *recordingObserver) ObserveLookup(_ context.Context, event i18n.LookupEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.events = append(o.events, event)
+}
+
+func TestLookupObserver(t *testing.T) {
+	observer := &recordingObserver{}
+	initI18n(t, i18n.WithLookupObserver(observer))
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	i18n.GetCtx(ctx, "hello")
+	i18n.GetCtx(ctx, "hello_english")
+	i18n.GetCtx(ctx, "unknown")
+	i18n.T("hello")
+	i18n.GetCtx(i18n.SetLangToContext(context.Background(), "de"), "hello")
+
+	require.Len(t, observer.events, 5)
+
+	testCases := []struct {
+		name     string
+		event    i18n.LookupEvent
+		expected i18n.LookupEvent
+	}{
+		{
+			name:     "translated",
+			event:    observer.events[0],
+			expected: i18n.LookupEvent{ID: "hello", Languages: []language.Tag{language.Indonesian}, Matched: language.Indonesian, Language: language.Indonesian},
+		},
+		{
+			name:     "fallback",
+			event:    observer.events[1],
+			expected: i18n.LookupEvent{ID: "hello_english", Languages: []language.Tag{language.Indonesian}, Matched: language.Indonesian, Language: language.English, Fallback: true},
+		},
+		{
+			name:     "missing",
+			event:    observer.events[2],
+			expected: i18n.LookupEvent{ID: "unknown", Languages: []language.Tag{language.Indonesian}, Matched: language.Indonesian, Language: language.Und, Missing: true},
+		},
+		{
+			name:     "default language",
+			event:    observer.events[3],
+			expected: i18n.LookupEvent{ID: "hello", Matched: language.English, Language: language.English},
+		},
+		{
+			name:     "language not loaded",
+			event:    observer.events[4],
+			expected: i18n.LookupEvent{ID: "hello", Languages: []language.Tag{language.German}, Matched: language.English, Language: language.English, Fallback: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.event.Duration = 0
+			assert.Equal(t, tc.expected, tc.event)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// messageOverrides are messages layered on the messages loaded by Init, such as tenant overrides and
+// messages added at runtime. They are never modified once created, updates replace them,
+// so lookups can use them without locking.
+type messageOverrides struct {
+	messages   map[language.Tag]map[string]*i18n.Message
+	localizers map[language.Tag]*i18n.Localizer
+}
+
+func newMessageOverrides(messages map[language.Tag]map[string]*i18n.Message) (*messageOverrides, error) {
+	bundle := i18n.NewBundle(language.Und)
+	localizers := make(map[language.Tag]*i18n.Localizer, len(messages))
+	for tag, tagMessages := range messages {
+		for _, message := range tagMessages {
+			if err := bundle.AddMessages(tag, message); err != nil {
+				return nil, err
+			}
+		}
+		localizers[tag] = i18n.NewLocalizer(bundle, tag.String())
+	}
+	return &messageOverrides{messages: messages, localizers: localizers}, nil
+}
+
+// update returns a copy of the overrides changed by update, overrides may be nil.
+func (o *messageOverrides) update(update func(map[language.Tag]map[string]*i18n.Message)) (*messageOverrides, error) {
+	messages := make(map[language.Tag]map[string]*i18n.Message)
+	if o != nil {
+		messages = maps.Clone(o.messages)
+	}
+	update(messages)
+	return newMessageOverrides(messages)
+}
+
+// has reports whether the overrides have the message for the language, overrides may be nil.
+func (o *messageOverrides) has(tag language.Tag, id string) bool {
+	if o == nil {
+		return false
+	}
+	_, ok := o.messages[tag][id]
+	return ok
+}
+
+// setOverrideMessages sets the messages of the language in the messages of overrides being updated.
+func setOverrideMessages(messages map[language.Tag]map[string]*i18n.Message, tag language.Tag, added []*i18n.Message) {
+	tagMessages := maps.Clone(messages[tag])
+	if tagMessages == nil {
+		tagMessages = make(map[string]*i18n.Message, len(added))
+	}
+	for _, message := range added {
+		tagMessages[message.ID] = message
+	}
+	messages[tag] = tagMessages
+}
+
+// removeOverrideMessage removes the message of the language from the messages of overrides being updated.
+func removeOverrideMessage(messages map[language.Tag]map[string]*i18n.Message, tag language.Tag, id string) {
+	if _, ok := messages[tag][id]; !ok {
+		return
+	}
+	tagMessages := maps.Clone(messages[tag])
+	delete(tagMessages, id)
+	messages[tag] = tagMessages
+}
+
+// copyMessages copies the messages, so callers can't change them once they are added.
+func copyMessages(messages []*Message) []*Message {
+	copies := make([]*Message, len(messages))
+	for i, message := range messages {
+		copied := *message
+		copies[i] = &copied
+	}
+	return copies
+}
+
+// localizeOverride localizes the override of the message for the languages of the localizer,
+// it returns an empty message if the overrides don't have the message.
+//
+// The override in the language the message is served in is used, or the override in the default language
+// if the message isn't translated to the matched language.
+func localizeOverride(ctx context.Context, overrides *messageOverrides, localizer *cachedLocalizer, id string,
+	cfg *localizeConfig, translated func(tag language.Tag, id string) bool,
+) (string, language.Tag) {
+	if overrides == nil {
+		return "", language.Und
+	}
+
+	tag := localizer.tag
+	if !overrides.has(tag, id) {
+		if translated(tag, id) {
+			return "", language.Und
+		}
+		tag = defaultLanguage
+		if !overrides.has(tag, id) {
+			return "", language.Und
+		}
+	}
+
+	localizeConfig := cfg.toI18nLocalizeConfig(id)
+	localizeConfig.DefaultMessage = nil
+	localizeConfig.TemplateParser = localizer.parserOf(tag)
+	message, err := overrides.localizers[tag].Localize(localizeConfig)
+	if err != nil {
+		logAttrs(ctx, slog.LevelError, "i18n: failed to execute message template",
+			slog.String("id", id), slog.String("language", tag.String()), slog.Any("error", err))
+	}
+	return message, tag
+}
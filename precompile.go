@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n/template"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// TemplateError is a message template that failed to parse.
+type TemplateError struct {
+	// Path is the file the message was loaded from.
+	Path string
+	// Language is the language of the message.
+	Language language.Tag
+	// ID is the message ID.
+	ID string
+	// Err is the parse error.
+	Err error
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("%s: %s: %s: %v", e.Path, e.Language, e.ID, e.Err)
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// PrecompileError is returned by Init with WithPrecompiledTemplates if any message template failed to parse.
+type PrecompileError struct {
+	// Errors are the message templates that failed to parse, sorted by language and message ID.
+	Errors []*TemplateError
+}
+
+func (e *PrecompileError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "i18n: %d message templates failed to parse:", len(e.Errors))
+	for _, err := range e.Errors {
+		b.WriteString("\n\t")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// templateSource identifies a message template by its source and delimiters.
+type templateSource struct {
+	src        string
+	leftDelim  string
+	rightDelim string
+}
+
+// compiledTemplates are the message templates parsed by Init with WithPrecompiledTemplates, per language.
+// The message parser returns them instead of parsing the templates again.
+var compiledTemplates map[language.Tag]map[templateSource]template.ParsedTemplate
+
+// compiledTemplate returns the precompiled template of the language.
+func compiledTemplate(tag language.Tag, src, leftDelim, rightDelim string) (template.ParsedTemplate, bool) {
+	parsed, ok := compiledTemplates[tag][templateSource{src: src, leftDelim: leftDelim, rightDelim: rightDelim}]
+	return parsed, ok
+}
+
+// precompileTemplates parses the templates of every plural form of every loaded message
+// with the parser of its language, and keeps them for rendering.
+func precompileTemplates() error {
+	tags := make([]language.Tag, 0, len(catalog))
+	for tag := range catalog {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].String() < tags[j].String()
+	})
+
+	compiled := make(map[language.Tag]map[templateSource]template.ParsedTemplate, len(tags))
+	var errs []*TemplateError
+	for _, tag := range tags {
+		parser := newMessageParser(tag, tag)
+		compiled[tag] = make(map[templateSource]template.ParsedTemplate)
+		for _, id := range catalogIDs(tag) {
+			entry := catalog[tag][id]
+			for _, form := range []plural.Form{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other} {
+				src := getPluralForm(entry.message, form)
+				if src == "" {
+					continue
+				}
+				source := templateSource{src: src, leftDelim: entry.message.LeftDelim, rightDelim: entry.message.RightDelim}
+				if _, ok := compiled[tag][source]; ok {
+					continue
+				}
+				parsed, err := parser.Parse(source.src, source.leftDelim, source.rightDelim)
+				if err != nil {
+					logAttrs(context.Background(), slog.LevelError, "i18n: failed to parse message template",
+						slog.String("id", id), slog.String("language", tag.String()),
+						slog.String("path", entry.path), slog.Any("error", err))
+					errs = append(errs, &TemplateError{Path: entry.path, Language: tag, ID: id, Err: err})
+					break
+				}
+				compiled[tag][source] = parsed
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &PrecompileError{Errors: errs}
+	}
+	compiledTemplates = compiled
+	return nil
+}
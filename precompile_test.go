@@ -0,0 +1,72 @@
+package i18n_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWithPrecompiledTemplates(t *testing.T) {
+	initI18n(t, i18n.WithPrecompiledTemplates())
+	// Messages with the same template share the parsed template.
+	assert.Equal(t, 44, i18n.CompiledTemplateCount(language.English))
+	assert.Equal(t, 18, i18n.CompiledTemplateCount(language.Indonesian))
+
+	ctx := i18n.SetLangToContext(context.Background(), "en")
+	testCases := []struct {
+		name     string
+		id       string
+		opts     []any
+		expected string
+	}{
+		{name: "params", id: "hello_name", opts: []any{i18n.Params{"name": "John"}}, expected: "Hello, John"},
+		{name: "template function", id: "total", opts: []any{i18n.Params{"amount": 12.5}}, expected: "Total: $12.50"},
+		{name: "plural one", id: "items", opts: []any{i18n.Count(1)}, expected: "1 item"},
+		{name: "plural other", id: "items", opts: []any{i18n.Count(3)}, expected: "3 items"},
+		{name: "default message", id: "unknown", opts: []any{i18n.Default("Hi, {{.name}}"), i18n.Params{"name": "Jane"}}, expected: "Hi, Jane"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, i18n.GetCtx(ctx, tc.id, tc.opts...))
+		})
+	}
+}
+
+func TestWithPrecompiledTemplatesError(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/en.yaml", "testdata/precompile_invalid.en.yaml", "testdata/precompile_invalid.id.yaml"),
+		i18n.WithMessageSyntax(i18n.ICUSyntax, "testdata/precompile_invalid.en.yaml"),
+		i18n.WithPrecompiledTemplates(),
+	)
+	require.Error(t, err)
+
+	var precompileErr *i18n.PrecompileError
+	require.True(t, errors.As(err, &precompileErr))
+	require.Len(t, precompileErr.Errors, 3)
+
+	expected := []struct {
+		path     string
+		language language.Tag
+		id       string
+	}{
+		{path: "testdata/precompile_invalid.en.yaml", language: language.English, id: "cart"},
+		{path: "testdata/precompile_invalid.id.yaml", language: language.Indonesian, id: "hello_name"},
+		{path: "testdata/precompile_invalid.id.yaml", language: language.Indonesian, id: "total"},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.path, precompileErr.Errors[i].Path)
+		assert.Equal(t, e.language, precompileErr.Errors[i].Language)
+		assert.Equal(t, e.id, precompileErr.Errors[i].ID)
+		assert.Error(t, precompileErr.Errors[i].Err)
+	}
+	assert.Contains(t, err.Error(), "i18n: 3 message templates failed to parse:")
+	assert.Contains(t, err.Error(), `testdata/precompile_invalid.id.yaml: id: total: template: :1: function "money" not defined`)
+}
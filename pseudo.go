@@ -0,0 +1,200 @@
+package i18n
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// Pseudo-locales served from the default language messages when pseudo-localization is enabled.
+var (
+	// PseudoAccented is en-XA, messages with accented letters and padding, e.g. "[Ĥéļļö, {{.name}}!~~]".
+	PseudoAccented = language.MustParse("en-XA")
+	// PseudoBidi is ar-XB, messages mirrored as right-to-left text.
+	PseudoBidi = language.MustParse("ar-XB")
+)
+
+// pseudoBundle localizes the pseudo-localized messages, it is nil if pseudo-localization is disabled.
+var (
+	pseudoBundle    *i18n.Bundle
+	pseudoExpansion int
+)
+
+var pseudoAccents = map[rune]rune{
+	'a': 'á', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î',
+	'j': 'ĵ', 'k': 'ķ', 'l': 'ļ', 'm': 'ɱ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ',
+	's': 'š', 't': 'ţ', 'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Ð', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î',
+	'J': 'Ĵ', 'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ',
+	'S': 'Š', 'T': 'Ţ', 'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+}
+
+const (
+	rightToLeftOverride  = '\u202e'
+	popDirectionalFormat = '\u202c'
+	rightToLeftMark      = '\u200f'
+	pseudoPadding        = '~'
+	pseudoBracketStart   = '['
+	pseudoBracketEnd     = ']'
+)
+
+var errPseudoMessageNotFound = errors.New("message not found in the default language")
+
+// pseudoLocale returns the pseudo-locale of the preferred language, if pseudo-localization is enabled.
+func pseudoLocale(tags []language.Tag) (language.Tag, bool) {
+	if pseudoBundle == nil {
+		return language.Und, false
+	}
+	if len(tags) == 0 || (tags[0] != PseudoAccented && tags[0] != PseudoBidi) {
+		return language.Und, false
+	}
+	return tags[0], true
+}
+
+// localizePseudo localizes the default language message of id, pseudo-localized for the pseudo-locale.
+func localizePseudo(tag language.Tag, id string, cfg *localizeConfig) (string, error) {
+	localizeConfig := cfg.toI18nLocalizeConfig(id)
+	message := localizeConfig.DefaultMessage
+	if entry, ok := catalog[defaultLanguage][id]; ok {
+		message = entry.message
+	}
+	if message == nil {
+		return "", errPseudoMessageNotFound
+	}
+
+	pseudo := *message
+	for form := range pluralFormNames {
+		if text := getPluralForm(message, form); text != "" {
+			setPluralForm(&pseudo, form, pseudoLocalize(tag, text, message.LeftDelim, message.RightDelim))
+		}
+	}
+	localizeConfig.DefaultMessage = &pseudo
+	localizeConfig.TemplateParser = messageParserOf(defaultLanguage)
+	return i18n.NewLocalizer(pseudoBundle).Localize(localizeConfig)
+}
+
+// pseudoLocalize transforms the text of a message source, keeping its placeholders intact.
+func pseudoLocalize(tag language.Tag, src, leftDelim, rightDelim string) string {
+	transform := pseudoAccent
+	if tag == PseudoBidi {
+		transform = pseudoMirror
+	}
+
+	var b strings.Builder
+	var letters int
+	for _, segment := range splitPlaceholders(src, leftDelim, rightDelim) {
+		if segment.placeholder {
+			b.WriteString(segment.text)
+			continue
+		}
+		for _, r := range segment.text {
+			if unicode.IsLetter(r) {
+				letters++
+			}
+		}
+		b.WriteString(transform(segment.text))
+	}
+
+	padding := strings.Repeat(string(pseudoPadding), int(math.Ceil(float64(letters*pseudoExpansion)/100)))
+	if tag == PseudoBidi {
+		return string(rightToLeftMark) + b.String() + padding
+	}
+	return string(pseudoBracketStart) + b.String() + padding + string(pseudoBracketEnd)
+}
+
+func pseudoAccent(text string) string {
+	return strings.Map(func(r rune) rune {
+		if accented, ok := pseudoAccents[r]; ok {
+			return accented
+		}
+		return r
+	}, text)
+}
+
+// pseudoMirror wraps the words in right-to-left overrides, so they are displayed mirrored.
+func pseudoMirror(text string) string {
+	var b strings.Builder
+	inWord := false
+	for _, r := range text {
+		isWordRune := !unicode.IsSpace(r)
+		if isWordRune && !inWord {
+			b.WriteRune(rightToLeftOverride)
+		}
+		if !isWordRune && inWord {
+			b.WriteRune(popDirectionalFormat)
+		}
+		inWord = isWordRune
+		b.WriteRune(r)
+	}
+	if inWord {
+		b.WriteRune(popDirectionalFormat)
+	}
+	return b.String()
+}
+
+type sourceSegment struct {
+	text        string
+	placeholder bool
+}
+
+// splitPlaceholders splits the message source into text and placeholders.
+//
+// Template actions between the delimiters are placeholders. In ICU messages, text is nested at even
+// brace depths, e.g. the cases of a plural argument, and argument syntax at odd depths.
+func splitPlaceholders(src, leftDelim, rightDelim string) []sourceSegment {
+	if leftDelim == icuLeftDelim && rightDelim == icuRightDelim {
+		return splitICUPlaceholders(src)
+	}
+	if leftDelim == "" {
+		leftDelim = "{{"
+	}
+	if rightDelim == "" {
+		rightDelim = "}}"
+	}
+
+	var segments []sourceSegment
+	for src != "" {
+		start := strings.Index(src, leftDelim)
+		if start < 0 {
+			break
+		}
+		end := strings.Index(src[start+len(leftDelim):], rightDelim)
+		if end < 0 {
+			break
+		}
+		end += start + len(leftDelim) + len(rightDelim)
+		if start > 0 {
+			segments = append(segments, sourceSegment{text: src[:start]})
+		}
+		segments = append(segments, sourceSegment{text: src[start:end], placeholder: true})
+		src = src[end:]
+	}
+	if src != "" {
+		segments = append(segments, sourceSegment{text: src})
+	}
+	return segments
+}
+
+func splitICUPlaceholders(src string) []sourceSegment {
+	var segments []sourceSegment
+	depth := 0
+	for _, r := range src {
+		placeholder := depth%2 == 1 || r == '{' || r == '}' || (r == '#' && depth > 0)
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+		if n := len(segments); n > 0 && segments[n-1].placeholder == placeholder {
+			segments[n-1].text += string(r)
+			continue
+		}
+		segments = append(segments, sourceSegment{text: string(r), placeholder: placeholder})
+	}
+	return segments
+}
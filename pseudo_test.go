@@ -0,0 +1,116 @@
+package i18n_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPseudoLocalization(t *testing.T) {
+	initI18n(t,
+		i18n.WithTranslationFile("testdata/icu.en.yaml"),
+		i18n.WithMessageSyntax(i18n.ICUSyntax, "testdata/icu.en.yaml"),
+		i18n.WithPseudoLocalization(40),
+	)
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		language        string
+		expectedMessage string
+	}{
+		{
+			name:            "accented",
+			messageID:       "test",
+			language:        "en-XA",
+			expectedMessage: "[Ţĥîš îš ţéšţ ɱéššáĝé~~~~~~~]",
+		},
+		{
+			name:            "placeholders are kept",
+			messageID:       "hello_name",
+			options:         []any{i18n.Param("name", "John")},
+			language:        "en-XA",
+			expectedMessage: "[Ĥéļļö, John~~]",
+		},
+		{
+			name:            "icu placeholders are kept",
+			messageID:       "cart",
+			options:         []any{i18n.Count(2)},
+			language:        "en-XA",
+			expectedMessage: "[2 îţéɱš~~~~~~~]",
+		},
+		{
+			name:            "mirrored",
+			messageID:       "hello_name",
+			options:         []any{i18n.Param("name", "John")},
+			language:        "ar-XB",
+			expectedMessage: "‏‮Hello,‬ John~~",
+		},
+		{
+			name:            "default message",
+			messageID:       "unknown",
+			options:         []any{i18n.Default("Hi")},
+			language:        "en-XA",
+			expectedMessage: "[Ĥî~]",
+		},
+		{
+			name:            "missing message",
+			messageID:       "unknown",
+			language:        "en-XA",
+			expectedMessage: `ERROR: missing translation for "unknown"`,
+		},
+		{
+			name:            "regular language",
+			messageID:       "test",
+			language:        "id",
+			expectedMessage: "Ini adalah pesan tes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.language)
+			assert.Equal(t, tc.expectedMessage, i18n.GetCtx(ctx, tc.messageID, tc.options...))
+		})
+	}
+}
+
+func TestPseudoLocalizationDisabled(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/en.yaml"),
+	)
+	require.NoError(t, err)
+
+	ctx := i18n.SetLangToContext(context.Background(), "en-XA")
+	assert.Equal(t, "Hello", i18n.GetCtx(ctx, "hello"))
+}
+
+func TestPseudoLocalizationMiddleware(t *testing.T) {
+	initI18n(t, i18n.WithPseudoLocalization(0))
+
+	handler := i18n.NewMiddleware(i18n.WithQueryKey("lang"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(i18n.TCtx(r.Context(), "hello")))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/?lang=en-XA", nil)
+	req.Header.Set("Accept-Language", "id")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "[Ĥéļļö]", rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "id")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "Halo", rec.Body.String())
+}
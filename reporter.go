@@ -0,0 +1,201 @@
+package i18n
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// MissingTranslation describes a lookup of a message missing in the requested language.
+type MissingTranslation struct {
+	// ID is the message ID.
+	ID string `json:"id"`
+	// Languages are the requested languages, from the Lang option and the context.
+	Languages []language.Tag `json:"languages"`
+	// FallbackLanguage is the language the message was served in, or language.Und if it wasn't found at all.
+	FallbackLanguage language.Tag `json:"fallbackLanguage"`
+	// Caller is the file and line of the code that looked up the message.
+	Caller string `json:"caller"`
+}
+
+// MissingTranslationReporter receives missing translations.
+//
+// It is called synchronously for every lookup of a missing message, so implementations should be fast
+// and must be safe for concurrent use.
+type MissingTranslationReporter interface {
+	ReportMissingTranslation(ctx context.Context, missing MissingTranslation)
+}
+
+var missingTranslationReporter MissingTranslationReporter
+
+// packagePath is the import path of the package, its frames are skipped when looking up the caller.
+var packagePath = reflect.TypeOf(localizeConfig{}).PkgPath()
+
+// reportMissingTranslation reports the lookup of a missing or fallback message.
+func reportMissingTranslation(ctx context.Context, event LookupEvent) {
+	if missingTranslationReporter == nil {
+		return
+	}
+	missingTranslationReporter.ReportMissingTranslation(ctx, MissingTranslation{
+		ID:               event.ID,
+		Languages:        event.Languages,
+		FallbackLanguage: event.Language,
+		Caller:           caller(),
+	})
+}
+
+// caller returns the location of the first caller outside the package.
+func caller() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, packagePath+".") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// missingTranslationKey identifies a missing translation for deduplication.
+func missingTranslationKey(missing MissingTranslation) string {
+	languages := make([]string, len(missing.Languages))
+	for i, tag := range missing.Languages {
+		languages[i] = tag.String()
+	}
+	return missing.ID + "|" + strings.Join(languages, ",") + "|" + missing.FallbackLanguage.String()
+}
+
+// MissingTranslationRecord is a missing translation collected by a MemoryReporter.
+type MissingTranslationRecord struct {
+	MissingTranslation
+	// Count is the number of lookups of the missing translation.
+	Count int `json:"count"`
+}
+
+// MemoryReporter collects missing translations in memory, deduplicated by ID, requested languages
+// and fallback language. The caller of the first lookup is kept.
+//
+// It is an http.Handler serving the collected missing translations as JSON, so it can be mounted
+// as a debug endpoint.
+//
+// Example:
+//
+//	reporter := i18n.NewMemoryReporter()
+//	err := i18n.Init(language.English, i18n.WithMissingTranslationReporter(reporter))
+//	mux.Handle("/debug/i18n/missing", reporter)
+type MemoryReporter struct {
+	mu      sync.Mutex
+	records map[string]*MissingTranslationRecord
+}
+
+// NewMemoryReporter creates a MemoryReporter.
+func NewMemoryReporter() *MemoryReporter {
+	return &MemoryReporter{records: make(map[string]*MissingTranslationRecord)}
+}
+
+// ReportMissingTranslation implements MissingTranslationReporter.
+func (r *MemoryReporter) ReportMissingTranslation(_ context.Context, missing MissingTranslation) {
+	key := missingTranslationKey(missing)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record, ok := r.records[key]
+	if !ok {
+		record = &MissingTranslationRecord{MissingTranslation: missing}
+		r.records[key] = record
+	}
+	record.Count++
+}
+
+// Records returns the collected missing translations sorted by ID.
+func (r *MemoryReporter) Records() []MissingTranslationRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	records := make([]MissingTranslationRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].ID != records[j].ID {
+			return records[i].ID < records[j].ID
+		}
+		return missingTranslationKey(records[i].MissingTranslation) < missingTranslationKey(records[j].MissingTranslation)
+	})
+	return records
+}
+
+// Reset removes the collected missing translations.
+func (r *MemoryReporter) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = make(map[string]*MissingTranslationRecord)
+}
+
+// ServeHTTP serves the collected missing translations as JSON.
+//
+// The "lang" query parameter filters the records by requested language.
+func (r *MemoryReporter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	records := r.Records()
+	if lang := req.URL.Query().Get("lang"); lang != "" {
+		filtered := records[:0]
+		for _, record := range records {
+			for _, tag := range record.Languages {
+				if tag.String() == lang {
+					filtered = append(filtered, record)
+					break
+				}
+			}
+		}
+		records = filtered
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(records)
+}
+
+// JSONLinesReporter writes missing translations to w as JSON lines, one line per missing translation.
+// Repeated lookups of the same missing translation are written once.
+//
+// Example:
+//
+//	file, err := os.OpenFile("missing.jsonl", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+//	reporter := i18n.NewJSONLinesReporter(file)
+type JSONLinesReporter struct {
+	mu   sync.Mutex
+	w    io.Writer
+	seen map[string]bool
+}
+
+// NewJSONLinesReporter creates a JSONLinesReporter writing to w.
+func NewJSONLinesReporter(w io.Writer) *JSONLinesReporter {
+	return &JSONLinesReporter{w: w, seen: make(map[string]bool)}
+}
+
+// ReportMissingTranslation implements MissingTranslationReporter.
+func (r *JSONLinesReporter) ReportMissingTranslation(_ context.Context, missing MissingTranslation) {
+	key := missingTranslationKey(missing)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.seen[key] {
+		return
+	}
+	r.seen[key] = true
+	data, err := json.Marshal(missing)
+	if err != nil {
+		return
+	}
+	_, _ = r.w.Write(append(data, '\n'))
+}
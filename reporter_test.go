@@ -0,0 +1,90 @@
+package i18n_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestMemoryReporter(t *testing.T) {
+	reporter := i18n.NewMemoryReporter()
+	initI18n(t, i18n.WithMissingTranslationReporter(reporter))
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	i18n.GetCtx(ctx, "hello")
+	i18n.GetCtx(ctx, "hello_english")
+	i18n.GetCtx(ctx, "hello_english")
+	i18n.GetCtx(ctx, "unknown")
+	i18n.T("hello_english")
+	i18n.GetCtx(i18n.SetLangToContext(context.Background(), "fr"), "hello")
+
+	records := reporter.Records()
+	require.Len(t, records, 3)
+
+	// A language that isn't loaded is served in the default language.
+	assert.Equal(t, "hello", records[0].ID)
+	assert.Equal(t, []language.Tag{language.French}, records[0].Languages)
+	assert.Equal(t, language.English, records[0].FallbackLanguage)
+
+	assert.Equal(t, "hello_english", records[1].ID)
+	assert.Equal(t, []language.Tag{language.Indonesian}, records[1].Languages)
+	assert.Equal(t, language.English, records[1].FallbackLanguage)
+	assert.Equal(t, 2, records[1].Count)
+	assert.Contains(t, records[1].Caller, "reporter_test.go:")
+
+	assert.Equal(t, "unknown", records[2].ID)
+	assert.Equal(t, language.Und, records[2].FallbackLanguage)
+	assert.Equal(t, 1, records[2].Count)
+
+	reporter.Reset()
+	assert.Empty(t, reporter.Records())
+}
+
+func TestMemoryReporterHandler(t *testing.T) {
+	reporter := i18n.NewMemoryReporter()
+	initI18n(t, i18n.WithMissingTranslationReporter(reporter))
+
+	i18n.GetCtx(i18n.SetLangToContext(context.Background(), "id"), "hello_english")
+	i18n.GetCtx(i18n.SetLangToContext(context.Background(), "en"), "unknown")
+
+	rec := httptest.NewRecorder()
+	reporter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/i18n/missing?lang=id", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var records []map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &records))
+	require.Len(t, records, 1)
+	assert.Equal(t, "hello_english", records[0]["id"])
+	assert.Equal(t, []any{"id"}, records[0]["languages"])
+	assert.Equal(t, "en", records[0]["fallbackLanguage"])
+	assert.Equal(t, float64(1), records[0]["count"])
+}
+
+func TestJSONLinesReporter(t *testing.T) {
+	var buf bytes.Buffer
+	initI18n(t, i18n.WithMissingTranslationReporter(i18n.NewJSONLinesReporter(&buf)))
+
+	ctx := i18n.SetLangToContext(context.Background(), "id")
+	i18n.GetCtx(ctx, "unknown")
+	i18n.GetCtx(ctx, "unknown")
+	i18n.GetCtx(ctx, "hello_english")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var missing i18n.MissingTranslation
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &missing))
+	assert.Equal(t, "unknown", missing.ID)
+	assert.Equal(t, []language.Tag{language.Indonesian}, missing.Languages)
+	assert.Equal(t, language.Und, missing.FallbackLanguage)
+	assert.Contains(t, missing.Caller, "reporter_test.go:")
+}
@@ -0,0 +1,124 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// runtimeMessages are the messages added after Init, they override the messages loaded by Init.
+var runtimeMessages = struct {
+	sync.RWMutex
+	overrides *messageOverrides
+}{}
+
+// AddMessages adds messages for the language after Init, e.g. messages a plugin defines in code.
+// Messages with the ID of a loaded message override it until they are removed.
+//
+// The language must be one of Languages. Lookups never see part of the messages: they are published
+// together once all of them are parsed. Added messages are kept when Init reloads the messages.
+//
+// Example:
+//
+//	err := i18n.AddMessages(language.English,
+//		&i18n.Message{ID: "plugin.title", Other: "Reports"},
+//		&i18n.Message{ID: "plugin.items", One: "{{.Count}} report", Other: "{{.Count}} reports"},
+//	)
+func AddMessages(tag language.Tag, messages ...*Message) error {
+	if bundle == nil {
+		return errors.New("i18n: i18n is not initialized")
+	}
+	if !slices.Contains(bundle.LanguageTags(), tag) {
+		return fmt.Errorf("i18n: language %s is not loaded", tag)
+	}
+	messages = copyMessages(messages)
+	setMessageSyntax(messageSyntax, messages...)
+
+	runtimeMessages.Lock()
+	defer runtimeMessages.Unlock()
+	updated, err := runtimeMessages.overrides.update(func(overrides map[language.Tag]map[string]*i18n.Message) {
+		setOverrideMessages(overrides, tag, messages)
+	})
+	if err != nil {
+		return err
+	}
+	runtimeMessages.overrides = updated
+	return nil
+}
+
+// SetMessage sets a message for the language after Init, see AddMessages.
+//
+// Example:
+//
+//	err := i18n.SetMessage(language.English, "plugin.title", "Reports")
+func SetMessage(tag language.Tag, id, text string) error {
+	return AddMessages(tag, &Message{ID: id, Other: text})
+}
+
+// RemoveMessage removes a message added with AddMessages or SetMessage for the language,
+// the message loaded by Init is used again.
+func RemoveMessage(tag language.Tag, id string) {
+	runtimeMessages.Lock()
+	defer runtimeMessages.Unlock()
+	if !runtimeMessages.overrides.has(tag, id) {
+		return
+	}
+	updated, err := runtimeMessages.overrides.update(func(overrides map[language.Tag]map[string]*i18n.Message) {
+		removeOverrideMessage(overrides, tag, id)
+	})
+	if err != nil {
+		return
+	}
+	runtimeMessages.overrides = updated
+}
+
+// ResetMessages removes all messages added with AddMessages or SetMessage.
+func ResetMessages() {
+	runtimeMessages.Lock()
+	defer runtimeMessages.Unlock()
+	runtimeMessages.overrides = nil
+}
+
+// currentRuntimeMessages returns the current runtime messages, or nil if there are none.
+func currentRuntimeMessages() *messageOverrides {
+	runtimeMessages.RLock()
+	defer runtimeMessages.RUnlock()
+	return runtimeMessages.overrides
+}
+
+// localizeRuntime localizes the message added at runtime, it returns an empty message
+// if no message was added for the ID.
+func localizeRuntime(ctx context.Context, localizer *cachedLocalizer, id string, cfg *localizeConfig) (string, language.Tag) {
+	return localizeOverride(ctx, currentRuntimeMessages(), localizer, id, cfg, func(tag language.Tag, id string) bool {
+		_, ok := catalog[tag][id]
+		return ok
+	})
+}
+
+// hasMessage reports whether the message is loaded or added at runtime for the language.
+func hasMessage(tag language.Tag, id string) bool {
+	if _, ok := catalog[tag][id]; ok {
+		return true
+	}
+	return currentRuntimeMessages().has(tag, id)
+}
+
+// messageIDs returns the IDs of the messages loaded or added at runtime for the language, sorted.
+// The overrides are the runtime messages snapshot the caller reads the messages from.
+func messageIDs(overrides *messageOverrides, tag language.Tag) []string {
+	ids := catalogIDs(tag)
+	if overrides != nil {
+		for id := range overrides.messages[tag] {
+			if _, ok := catalog[tag][id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+		slices.Sort(ids)
+	}
+	return ids
+}
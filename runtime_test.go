@@ -0,0 +1,133 @@
+package i18n_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestAddMessages(t *testing.T) {
+	initI18n(t)
+	require.NoError(t, i18n.AddMessages(language.English,
+		&i18n.Message{ID: "plugin.title", Other: "Reports"},
+		&i18n.Message{ID: "plugin.reports", One: "{{.Count}} report", Other: "{{.Count}} reports"},
+		&i18n.Message{ID: "hello_name", Other: "Hi, {{.name}}!"},
+	))
+	require.NoError(t, i18n.SetMessage(language.Indonesian, "plugin.title", "Laporan"))
+
+	testCases := []struct {
+		name     string
+		lang     string
+		id       string
+		opts     []any
+		expected string
+	}{
+		{name: "added message", id: "plugin.title", expected: "Reports"},
+		{name: "added message in the language", lang: "id", id: "plugin.title", expected: "Laporan"},
+		{name: "plural message", id: "plugin.reports", opts: []any{i18n.Count(2)}, expected: "2 reports"},
+		{name: "default language fallback", lang: "id", id: "plugin.reports", opts: []any{i18n.Count(1)}, expected: "1 report"},
+		{name: "override of loaded message", id: "hello_name", opts: []any{i18n.Param("name", "John")}, expected: "Hi, John!"},
+		{name: "loaded message", lang: "id", id: "title", expected: "Dasbor"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			assert.Equal(t, tc.expected, i18n.GetCtx(ctx, tc.id, tc.opts...))
+		})
+	}
+
+	assert.True(t, i18n.HasMessage(language.Indonesian, "plugin.title"))
+	assert.False(t, i18n.HasMessage(language.Indonesian, "plugin.reports"))
+	assert.Subset(t, i18n.Messages(language.English), []i18n.MessageInfo{
+		{ID: "hello_name", PluralForms: []string{"other"}},
+		{ID: "plugin.reports", PluralForms: []string{"one", "other"}},
+		{ID: "plugin.title", PluralForms: []string{"other"}},
+		{ID: "title", PluralForms: []string{"other"}, Path: "testdata/en.yaml"},
+	})
+	assert.Equal(t, map[string]string{"plugin.title": "Reports"}, i18n.GetAllWithPrefix(context.Background(), "plugin.t"))
+}
+
+func TestRemoveMessage(t *testing.T) {
+	initI18n(t)
+	require.NoError(t, i18n.SetMessage(language.English, "title", "Overview"))
+	require.NoError(t, i18n.SetMessage(language.English, "plugin.title", "Reports"))
+	assert.Equal(t, "Overview", i18n.Get("title"))
+
+	i18n.RemoveMessage(language.English, "title")
+	assert.Equal(t, "Dashboard", i18n.Get("title"))
+	assert.Equal(t, "Reports", i18n.Get("plugin.title"))
+
+	i18n.ResetMessages()
+	assert.Equal(t, `ERROR: missing translation for "plugin.title"`, i18n.Get("plugin.title"))
+	assert.False(t, i18n.HasMessage(language.English, "plugin.title"))
+}
+
+func TestAddMessagesKeptOnReload(t *testing.T) {
+	initI18n(t)
+	require.NoError(t, i18n.SetMessage(language.English, "plugin.title", "Reports"))
+	initI18n(t)
+	assert.Equal(t, "Reports", i18n.Get("plugin.title"))
+}
+
+func TestAddMessagesErrors(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.SetMessage(language.English, "plugin.title", "Reports")
+	assert.EqualError(t, err, "i18n: i18n is not initialized")
+
+	initI18n(t)
+	err = i18n.SetMessage(language.French, "plugin.title", "Rapports")
+	assert.EqualError(t, err, "i18n: language fr is not loaded")
+}
+
+func TestAddMessagesConcurrent(t *testing.T) {
+	initI18n(t)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			id := fmt.Sprintf("plugin.message%d", i)
+			assert.NoError(t, i18n.SetMessage(language.English, id, "Message"))
+			i18n.RemoveMessage(language.English, id)
+		}()
+		go func() {
+			defer wg.Done()
+			ctx := i18n.SetLangToContext(context.Background(), "id")
+			for range 100 {
+				assert.Equal(t, "Dasbor", i18n.GetCtx(ctx, "title"))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMessagesConcurrentRemoveMessage(t *testing.T) {
+	initI18n(t)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			id := fmt.Sprintf("plugin.message%d", i)
+			for range 20 {
+				assert.NoError(t, i18n.SetMessage(language.English, id, "Message"))
+				i18n.RemoveMessage(language.English, id)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				assert.NotEmpty(t, i18n.Messages(language.English))
+			}
+		}()
+	}
+	wg.Wait()
+}
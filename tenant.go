@@ -0,0 +1,139 @@
+package i18n
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"sync"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+const tenantCtxKey contextKey = "i18n-tenant"
+
+// tenants is the registry of tenant message overrides, keyed by tenant ID.
+var tenants = struct {
+	sync.RWMutex
+	overrides map[string]*messageOverrides
+}{}
+
+// fileConfig is the config of Init, used to parse message files loaded after Init.
+var fileConfig *config
+
+// SetTenantToContext sets the tenant to the context, so GetCtx checks the message overrides of the tenant
+// before the messages loaded by Init.
+//
+// Example:
+//
+//	ctx = i18n.SetTenantToContext(ctx, "acme")
+func SetTenantToContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey, tenant)
+}
+
+// GetTenant returns the tenant of the context, or an empty string if it has none.
+func GetTenant(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantCtxKey).(string)
+	return tenant
+}
+
+// SetTenantMessages sets message overrides of the tenant for the language.
+// Lookups of the tenant running meanwhile use the previous overrides until all the messages are set.
+//
+// Example:
+//
+//	err := i18n.SetTenantMessages("acme", language.English, &i18n.Message{ID: "workspace", Other: "Project"})
+func SetTenantMessages(tenant string, tag language.Tag, messages ...*Message) error {
+	messages = copyMessages(messages)
+	setMessageSyntax(messageSyntax, messages...)
+	return updateTenant(tenant, func(overrides map[language.Tag]map[string]*i18n.Message) {
+		setOverrideMessages(overrides, tag, messages)
+	})
+}
+
+// SetTenantMessage sets a message override of the tenant for the language.
+//
+// Example:
+//
+//	err := i18n.SetTenantMessage("acme", language.English, "workspace", "Project")
+func SetTenantMessage(tenant string, tag language.Tag, id, text string) error {
+	return SetTenantMessages(tenant, tag, &Message{ID: id, Other: text})
+}
+
+// LoadTenantFile loads message overrides of the tenant from message files,
+// parsed with the options of Init. The language of each file is taken from its name like in Init.
+//
+// Example:
+//
+//	err := i18n.LoadTenantFile("acme", "tenants/acme/en.yaml", "tenants/acme/id.yaml")
+func LoadTenantFile(tenant string, paths ...string) error {
+	return LoadTenantFS(tenant, nil, paths...)
+}
+
+// LoadTenantFS loads message overrides of the tenant from message files of the file system.
+//
+// It is similar to LoadTenantFile, but it reads the files from fsys.
+func LoadTenantFS(tenant string, fsys fs.FS, paths ...string) error {
+	if fileConfig == nil {
+		return errors.New("i18n is not initialized")
+	}
+	files := make([]*i18n.MessageFile, 0, len(paths))
+	for _, path := range paths {
+		data, err := readFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		file, err := parseMessageFile(data, path, fileConfig)
+		if err != nil {
+			return err
+		}
+		setMessageSyntax(fileConfig.syntaxOf(path), file.Messages...)
+		files = append(files, file)
+	}
+	return updateTenant(tenant, func(overrides map[language.Tag]map[string]*i18n.Message) {
+		for _, file := range files {
+			setOverrideMessages(overrides, file.Tag, file.Messages)
+		}
+	})
+}
+
+// RemoveTenantMessage removes a message override of the tenant for the language.
+func RemoveTenantMessage(tenant string, tag language.Tag, id string) {
+	_ = updateTenant(tenant, func(overrides map[language.Tag]map[string]*i18n.Message) {
+		removeOverrideMessage(overrides, tag, id)
+	})
+}
+
+// RemoveTenant removes all message overrides of the tenant.
+func RemoveTenant(tenant string) {
+	tenants.Lock()
+	defer tenants.Unlock()
+	delete(tenants.overrides, tenant)
+}
+
+// updateTenant replaces the overrides of the tenant with a copy changed by update.
+func updateTenant(tenant string, update func(map[language.Tag]map[string]*i18n.Message)) error {
+	tenants.Lock()
+	defer tenants.Unlock()
+
+	updated, err := tenants.overrides[tenant].update(update)
+	if err != nil {
+		return err
+	}
+	if tenants.overrides == nil {
+		tenants.overrides = make(map[string]*messageOverrides)
+	}
+	tenants.overrides[tenant] = updated
+	return nil
+}
+
+// localizeTenant localizes the override of the message of the tenant, it returns an empty message
+// if the tenant doesn't override the message. Messages added at runtime count as translated.
+func localizeTenant(ctx context.Context, tenant string, localizer *cachedLocalizer, id string, cfg *localizeConfig) (string, language.Tag) {
+	tenants.RLock()
+	overrides := tenants.overrides[tenant]
+	tenants.RUnlock()
+	return localizeOverride(ctx, overrides, localizer, id, cfg, func(tag language.Tag, id string) bool {
+		return hasMessage(tag, id)
+	})
+}
@@ -0,0 +1,132 @@
+package i18n_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestTenantOverrides(t *testing.T) {
+	initI18n(t)
+	require.NoError(t, i18n.LoadTenantFile("acme", "testdata/acme.en.yaml", "testdata/acme.id.yaml"))
+
+	testCases := []struct {
+		name     string
+		tenant   string
+		lang     string
+		id       string
+		opts     []any
+		expected string
+	}{
+		{name: "without tenant", id: "workspace", expected: "Workspace"},
+		{name: "override", tenant: "acme", id: "workspace", expected: "Project"},
+		{name: "plural override", tenant: "acme", id: "workspaces", opts: []any{i18n.Count(1)}, expected: "1 project"},
+		{name: "template override", tenant: "acme", id: "invite", opts: []any{i18n.Param("name", "John")}, expected: "Invite John to the project"},
+		{name: "override in the tenant language", tenant: "acme", lang: "id", id: "invite", opts: []any{i18n.Param("name", "John")}, expected: "Undang John ke proyek"},
+		{name: "translation wins over default language override", tenant: "acme", lang: "id", id: "workspace", expected: "Ruang kerja"},
+		{name: "default language override of untranslated message", tenant: "acme", lang: "id", id: "workspaces", opts: []any{i18n.Count(3)}, expected: "3 projects"},
+		{name: "tenant without overrides", tenant: "globex", id: "workspace", expected: "Workspace"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := i18n.SetLangToContext(context.Background(), tc.lang)
+			if tc.tenant != "" {
+				ctx = i18n.SetTenantToContext(ctx, tc.tenant)
+			}
+			assert.Equal(t, tc.expected, i18n.GetCtx(ctx, tc.id, tc.opts...))
+		})
+	}
+}
+
+func TestSetTenantMessage(t *testing.T) {
+	initI18n(t)
+	ctx := i18n.SetTenantToContext(context.Background(), "acme")
+
+	require.NoError(t, i18n.SetTenantMessage("acme", language.English, "workspace", "Team"))
+	assert.Equal(t, "Team", i18n.GetCtx(ctx, "workspace"))
+	assert.Equal(t, "acme", i18n.GetTenant(ctx))
+
+	require.NoError(t, i18n.SetTenantMessages("acme", language.English,
+		&i18n.Message{ID: "workspace", Other: "Space"},
+		&i18n.Message{ID: "invite", Other: "Add {{.name}}"},
+	))
+	assert.Equal(t, "Space", i18n.GetCtx(ctx, "workspace"))
+	assert.Equal(t, "Add John", i18n.GetCtx(ctx, "invite", i18n.Param("name", "John")))
+
+	i18n.RemoveTenantMessage("acme", language.English, "workspace")
+	assert.Equal(t, "Workspace", i18n.GetCtx(ctx, "workspace"))
+	assert.Equal(t, "Add John", i18n.GetCtx(ctx, "invite", i18n.Param("name", "John")))
+
+	i18n.RemoveTenant("acme")
+	assert.Equal(t, "Invite John to the workspace", i18n.GetCtx(ctx, "invite", i18n.Param("name", "John")))
+}
+
+func TestLoadTenantFileError(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	assert.Error(t, i18n.LoadTenantFile("acme", "testdata/acme.en.yaml"))
+
+	initI18n(t)
+	assert.Error(t, i18n.LoadTenantFile("acme", "testdata/missing.en.yaml"))
+}
+
+func TestTenantConcurrentUpdates(t *testing.T) {
+	initI18n(t)
+	ctx := i18n.SetTenantToContext(context.Background(), "acme")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_ = i18n.SetTenantMessage("acme", language.English, "workspace", fmt.Sprintf("Project %d", i))
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				assert.NotEmpty(t, i18n.GetCtx(ctx, "workspace"))
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Contains(t, i18n.GetCtx(ctx, "workspace"), "Project")
+}
+
+func TestMiddlewareWithTenantHandler(t *testing.T) {
+	initI18n(t)
+	require.NoError(t, i18n.SetTenantMessage("acme", language.English, "workspace", "Project"))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(i18n.TCtx(r.Context(), "workspace")))
+	})
+	middleware := i18n.NewMiddleware(i18n.WithTenantHandler(func(r *http.Request) string {
+		return r.Header.Get("X-Tenant-ID")
+	}))
+
+	testCases := []struct {
+		name     string
+		tenant   string
+		expected string
+	}{
+		{name: "tenant", tenant: "acme", expected: "Project"},
+		{name: "without tenant", expected: "Workspace"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("X-Tenant-ID", tc.tenant)
+			rec := httptest.NewRecorder()
+			middleware(handler).ServeHTTP(rec, req)
+			assert.Equal(t, tc.expected, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Unit is a unit of measurement.
+type Unit string
+
+// Units of mass, length, volume, temperature and duration.
+const (
+	UnitGram       Unit = "gram"
+	UnitKilogram   Unit = "kilogram"
+	UnitOunce      Unit = "ounce"
+	UnitPound      Unit = "pound"
+	UnitCentimeter Unit = "centimeter"
+	UnitMeter      Unit = "meter"
+	UnitKilometer  Unit = "kilometer"
+	UnitInch       Unit = "inch"
+	UnitFoot       Unit = "foot"
+	UnitMile       Unit = "mile"
+	UnitLiter      Unit = "liter"
+	UnitGallon     Unit = "gallon"
+	UnitCelsius    Unit = "celsius"
+	UnitFahrenheit Unit = "fahrenheit"
+	UnitSecond     Unit = "second"
+	UnitMinute     Unit = "minute"
+	UnitHour       Unit = "hour"
+	UnitDay        Unit = "day"
+	UnitWeek       Unit = "week"
+)
+
+// UnitWidth is the CLDR width of a formatted unit.
+type UnitWidth string
+
+const (
+	// UnitLong spells out the unit, e.g. "5 kilometers".
+	UnitLong UnitWidth = "long"
+	// UnitShort abbreviates the unit, e.g. "5 km".
+	UnitShort UnitWidth = "short"
+	// UnitNarrow is the most compact form, e.g. "5km".
+	UnitNarrow UnitWidth = "narrow"
+)
+
+// unitMessagePrefix is followed by the unit and the width, e.g. "i18n.unit.kilometer.long",
+// plural messages with the value as {0}.
+const unitMessagePrefix = "i18n.unit."
+
+// convertMeasurement converts units to the measurement system of the language before formatting.
+var convertMeasurement bool
+
+type unitConversion struct {
+	unit    Unit
+	convert func(float64) float64
+}
+
+func scaleUnit(unit Unit, factor float64) unitConversion {
+	return unitConversion{unit: unit, convert: func(v float64) float64 { return v * factor }}
+}
+
+// metricToUS and usToMetric convert between the metric and the US measurement systems.
+var (
+	metricToUS = map[Unit]unitConversion{
+		UnitGram:       scaleUnit(UnitOunce, 1/28.349523125),
+		UnitKilogram:   scaleUnit(UnitPound, 1/0.45359237),
+		UnitCentimeter: scaleUnit(UnitInch, 1/2.54),
+		UnitMeter:      scaleUnit(UnitFoot, 1/0.3048),
+		UnitKilometer:  scaleUnit(UnitMile, 1/1.609344),
+		UnitLiter:      scaleUnit(UnitGallon, 1/3.785411784),
+		UnitCelsius:    {unit: UnitFahrenheit, convert: func(v float64) float64 { return v*9/5 + 32 }},
+	}
+	usToMetric = map[Unit]unitConversion{
+		UnitOunce:      scaleUnit(UnitGram, 28.349523125),
+		UnitPound:      scaleUnit(UnitKilogram, 0.45359237),
+		UnitInch:       scaleUnit(UnitCentimeter, 2.54),
+		UnitFoot:       scaleUnit(UnitMeter, 0.3048),
+		UnitMile:       scaleUnit(UnitKilometer, 1.609344),
+		UnitGallon:     scaleUnit(UnitLiter, 3.785411784),
+		UnitFahrenheit: {unit: UnitCelsius, convert: func(v float64) float64 { return (v - 32) * 5 / 9 }},
+	}
+)
+
+// FormatUnit formats the value of the unit with the CLDR unit pattern of the width in the context language.
+//
+// With WithMeasurementConversion, metric units are converted to US units for languages of
+// the United States, Liberia and Myanmar, and US units to metric units for all other languages.
+// Built-in patterns cover English, Indonesian and Japanese, and can be added or overridden with
+// "i18n.unit" messages in the locale files.
+//
+// Example:
+//
+//	i18n.FormatUnit(ctx, 5, i18n.UnitKilometer, i18n.UnitLong) // 5 kilometers in English, 5 kilometer in Indonesian
+func FormatUnit(ctx context.Context, value any, unit Unit, width UnitWidth) string {
+	return formatUnit(contextLanguage(ctx), value, unit, width)
+}
+
+func formatUnit(tag language.Tag, value any, unit Unit, width UnitWidth) string {
+	v, ok := toFloat(value)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	if convertMeasurement {
+		conversions := usToMetric
+		if usesUSMeasurement(tag) {
+			conversions = metricToUS
+		}
+		if conversion, ok := conversions[unit]; ok {
+			unit, v = conversion.unit, conversion.convert(v)
+		}
+	}
+
+	formatted := message.NewPrinter(tag).Sprint(number.Decimal(v, number.MaxFractionDigits(2)))
+	pattern := "{0} " + string(unit)
+	if message := localeMessage(tag, unitMessagePrefix+string(unit)+"."+string(width)); message != nil {
+		pattern = getPluralForm(message, matchPluralForm(plural.Cardinal, tag, roundFraction(v, 2)))
+		if pattern == "" {
+			pattern = message.Other
+		}
+	}
+	return strings.Replace(pattern, "{0}", formatted, 1)
+}
+
+// usesUSMeasurement reports whether the region of the language uses the US measurement system.
+func usesUSMeasurement(tag language.Tag) bool {
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return false
+	}
+	switch region.String() {
+	case "US", "LR", "MM":
+		return true
+	}
+	return false
+}
+
+func roundFraction(v float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,140 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestFormatUnit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		options  []i18n.Option
+		language string
+		value    any
+		unit     i18n.Unit
+		width    i18n.UnitWidth
+		expected string
+	}{
+		{
+			name:     "long",
+			value:    5,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitLong,
+			expected: "5 kilometers",
+		},
+		{
+			name:     "long singular",
+			value:    1,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitLong,
+			expected: "1 kilometer",
+		},
+		{
+			name:     "short",
+			value:    1234.5,
+			unit:     i18n.UnitKilogram,
+			width:    i18n.UnitShort,
+			expected: "1,234.5 kg",
+		},
+		{
+			name:     "narrow",
+			value:    5,
+			unit:     i18n.UnitHour,
+			width:    i18n.UnitNarrow,
+			expected: "5h",
+		},
+		{
+			name:     "indonesian",
+			language: "id",
+			value:    5,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitLong,
+			expected: "5 kilometer",
+		},
+		{
+			name:     "indonesian decimal",
+			language: "id",
+			value:    2.5,
+			unit:     i18n.UnitKilogram,
+			width:    i18n.UnitShort,
+			expected: "2,5 kg",
+		},
+		{
+			name:     "japanese",
+			language: "ja",
+			value:    5,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitLong,
+			expected: "5 キロメートル",
+		},
+		{
+			name:     "convert to us system",
+			options:  []i18n.Option{i18n.WithMeasurementConversion()},
+			language: "en-US",
+			value:    10,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitLong,
+			expected: "6.21 miles",
+		},
+		{
+			name:     "convert to metric system",
+			options:  []i18n.Option{i18n.WithMeasurementConversion()},
+			language: "en-GB",
+			value:    10,
+			unit:     i18n.UnitPound,
+			width:    i18n.UnitShort,
+			expected: "4.54 kg",
+		},
+		{
+			name:     "convert temperature",
+			options:  []i18n.Option{i18n.WithMeasurementConversion()},
+			language: "en-US",
+			value:    100,
+			unit:     i18n.UnitCelsius,
+			width:    i18n.UnitShort,
+			expected: "212°F",
+		},
+		{
+			name:     "no conversion without option",
+			language: "en-US",
+			value:    10,
+			unit:     i18n.UnitKilometer,
+			width:    i18n.UnitShort,
+			expected: "10 km",
+		},
+		{
+			name:     "durations are not converted",
+			options:  []i18n.Option{i18n.WithMeasurementConversion()},
+			language: "en-US",
+			value:    2,
+			unit:     i18n.UnitDay,
+			width:    i18n.UnitShort,
+			expected: "2 days",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Cleanup(i18n.Reset)
+			err := i18n.Init(language.English, tc.options...)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expected, i18n.FormatUnit(ctx, tc.value, tc.unit, tc.width))
+		})
+	}
+}
+
+func TestUnitTemplateFunc(t *testing.T) {
+	initI18n(t)
+
+	assert.Equal(t, "3.5 km away", i18n.T("distance", i18n.Param("distance", 3.5)))
+}
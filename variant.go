@@ -0,0 +1,127 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// variantSelectKey is the key of a message map that defines variants, the name of the selector.
+// It is reserved, so maps with a plain "select" key stay nested keys.
+//
+//	invited:
+//	  _select: gender
+//	  male: He invited you
+//	  female: She invited you
+//	  other: They invited you
+const variantSelectKey = "_select"
+
+// variantOther is the variant used when no variant matches the selected value.
+const variantOther = "other"
+
+type selection struct {
+	key   string
+	value string
+}
+
+// variantID returns the ID of the message variant, e.g. "invited[gender=female]".
+func variantID(id, key, value string) string {
+	return id + "[" + key + "=" + value + "]"
+}
+
+// isVariantID reports whether the message ID is the ID of a message variant.
+func isVariantID(id string) bool {
+	return strings.HasSuffix(id, "]") && strings.Contains(id, "[")
+}
+
+// isVariantMap reports whether the map defines message variants.
+func isVariantMap(data map[string]any) bool {
+	_, ok := data[variantSelectKey].(string)
+	return ok
+}
+
+// variantMessages returns the messages of the variants defined by data.
+//
+// The "other" variant is the message itself, so lookups without a selection or with
+// an unknown value use it. Every variant may be a plural message.
+func variantMessages(id string, data map[string]any) ([]*i18n.Message, error) {
+	key := data[variantSelectKey].(string)
+	if _, ok := data[variantOther]; !ok {
+		return nil, fmt.Errorf("%s: %w", id, errMissingOtherVariant)
+	}
+	description, _ := data["description"].(string)
+
+	values := make([]string, 0, len(data))
+	for value := range data {
+		if value != variantSelectKey && value != "description" {
+			values = append(values, value)
+		}
+	}
+	sort.Strings(values)
+
+	messages := make([]*i18n.Message, 0, len(values))
+	for _, value := range values {
+		message, err := i18n.NewMessage(data[value])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		message.ID = variantID(id, key, value)
+		if value == variantOther {
+			message.ID = id
+		}
+		if message.Description == "" {
+			message.Description = description
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+var errMissingOtherVariant = errors.New(`message variants must define an "other" variant`)
+
+// hasVariants reports whether raw message file data defines message variants anywhere.
+func hasVariants(raw any) bool {
+	data, err := stringKeyMap(raw)
+	if err != nil {
+		return false
+	}
+	if isVariantMap(data) {
+		return true
+	}
+	for _, value := range data {
+		if hasVariants(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveVariantID returns the ID of the variant of the message for the selections,
+// the first selection with a variant in the language of the message wins.
+func resolveVariantID(languages []string, id string, selections []selection) string {
+	tag := messageLanguage(languages, id)
+	for _, s := range selections {
+		candidate := variantID(id, s.key, s.value)
+		if hasMessage(tag, candidate) {
+			return candidate
+		}
+	}
+	return id
+}
+
+// Select selects the variant of the message for the value of the selector key, e.g. the
+// grammatical gender of the user. Messages without a variant for the value use their "other" variant.
+//
+// The value is also available in the template as {{.key}} unless the param is set explicitly.
+//
+// Example:
+//
+//	i18n.T("invited", i18n.Select("gender", "female"), i18n.Count(3))
+func Select(key, value string) LocalizeOption {
+	return func(c *localizeConfig) {
+		c.selections = append(c.selections, selection{key: key, value: value})
+	}
+}
@@ -0,0 +1,122 @@
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSelect(t *testing.T) {
+	initI18n(t, i18n.WithTranslationFile("testdata/fr.yaml"))
+
+	testCases := []struct {
+		name            string
+		messageID       string
+		options         []any
+		language        string
+		expectedMessage string
+	}{
+		{
+			name:            "variant",
+			messageID:       "invited",
+			options:         []any{i18n.Select("gender", "female")},
+			expectedMessage: "She invited you",
+		},
+		{
+			name:            "fallback to other variant",
+			messageID:       "invited",
+			options:         []any{i18n.Select("gender", "unknown")},
+			expectedMessage: "They invited you",
+		},
+		{
+			name:            "without selection",
+			messageID:       "invited",
+			expectedMessage: "They invited you",
+		},
+		{
+			name:            "variant missing in language falls back to other variant of the language",
+			messageID:       "invited",
+			options:         []any{i18n.Select("gender", "male")},
+			language:        "fr",
+			expectedMessage: "Il vous a invité",
+		},
+		{
+			name:            "variant with params",
+			messageID:       "welcome",
+			options:         []any{i18n.Select("gender", "female"), i18n.Param("name", "Marie")},
+			language:        "fr",
+			expectedMessage: "Bienvenue, Marie ! Vous êtes connectée.",
+		},
+		{
+			name:            "plural variant",
+			messageID:       "profile.shared",
+			options:         []any{i18n.Select("gender", "male"), i18n.Count(1)},
+			expectedMessage: "He shared 1 photo",
+		},
+		{
+			name:            "plural other variant",
+			messageID:       "profile.shared",
+			options:         []any{i18n.Select("gender", "female"), i18n.Count(2)},
+			expectedMessage: "They shared 2 photos",
+		},
+		{
+			name:            "first selection with a variant wins",
+			messageID:       "invited",
+			options:         []any{i18n.Select("formality", "formal"), i18n.Select("gender", "male")},
+			expectedMessage: "He invited you",
+		},
+		{
+			name:            "message without variants",
+			messageID:       "hello",
+			options:         []any{i18n.Select("gender", "male")},
+			expectedMessage: "Hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.language != "" {
+				ctx = i18n.SetLangToContext(ctx, tc.language)
+			}
+			assert.Equal(t, tc.expectedMessage, i18n.GetCtx(ctx, tc.messageID, tc.options...))
+		})
+	}
+}
+
+func TestSelectNestedKeys(t *testing.T) {
+	initI18n(t, i18n.WithNestedKeys("/"))
+
+	assert.Equal(t, "He shared 3 photos", i18n.T("profile/shared", i18n.Select("gender", "male"), i18n.Count(3)))
+}
+
+func TestSelectICU(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English, i18n.WithMessageSyntax(i18n.ICUSyntax))
+	require.NoError(t, err)
+
+	message := i18n.T("invited", i18n.Default("{gender, select, female {She} other {They}} invited you"), i18n.Select("gender", "female"))
+	assert.Equal(t, "She invited you", message)
+}
+
+func TestSelectPlainSelectKey(t *testing.T) {
+	initI18n(t)
+
+	assert.Equal(t, "Select", i18n.T("buttons.select"))
+	assert.Equal(t, "Cancel", i18n.T("buttons.cancel"))
+	assert.Equal(t, "She invited you", i18n.T("invited", i18n.Select("gender", "female")))
+}
+
+func TestSelectMissingOtherVariant(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	err := i18n.Init(language.English,
+		i18n.WithUnmarshalFunc("yaml", yaml.Unmarshal),
+		i18n.WithTranslationFile("testdata/variant_invalid.en.yaml"),
+	)
+	assert.ErrorContains(t, err, `message variants must define an "other" variant`)
+}
@@ -0,0 +1,331 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// XLIFFVersion is the version of an XLIFF document.
+type XLIFFVersion string
+
+const (
+	// XLIFF12 is XLIFF version 1.2.
+	XLIFF12 XLIFFVersion = "1.2"
+	// XLIFF20 is XLIFF version 2.0.
+	XLIFF20 XLIFFVersion = "2.0"
+)
+
+const (
+	xliff12Namespace = "urn:oasis:names:tc:xliff:document:1.2"
+	xliff20Namespace = "urn:oasis:names:tc:xliff:document:2.0"
+	xliffFileID      = "messages"
+)
+
+// XLIFFDocument is a translation document exchanged with translators.
+type XLIFFDocument struct {
+	Version        XLIFFVersion
+	SourceLanguage language.Tag
+	TargetLanguage language.Tag
+	Units          []XLIFFUnit
+}
+
+// XLIFFUnit is a single translation unit.
+//
+// Plural messages are split into one unit per plural form of the target language,
+// with the form appended to the ID, e.g. "items[one]" and "items[other]".
+type XLIFFUnit struct {
+	ID     string
+	Source string
+	Target string
+	Note   string
+}
+
+// ExportXLIFF writes the messages of the source language as an XLIFF document for translation
+// into the target language.
+//
+// Existing target translations are included, and message descriptions are exported as notes.
+//
+// Example:
+//
+//	err := i18n.ExportXLIFF(w, language.English, language.Indonesian, i18n.XLIFF20)
+func ExportXLIFF(w io.Writer, source, target language.Tag, version XLIFFVersion) error {
+	if bundle == nil {
+		return errors.New("i18n is not initialized")
+	}
+	doc := &XLIFFDocument{
+		Version:        version,
+		SourceLanguage: source,
+		TargetLanguage: target,
+	}
+	forms := pluralForms(target)
+	for _, id := range catalogIDs(source) {
+		sourceMessage := catalog[source][id].message
+		targetMessage := &i18n.Message{}
+		if entry, ok := catalog[target][id]; ok {
+			targetMessage = entry.message
+		}
+
+		if !isPluralMessage(sourceMessage) {
+			doc.Units = append(doc.Units, XLIFFUnit{
+				ID:     id,
+				Source: sourceMessage.Other,
+				Target: targetMessage.Other,
+				Note:   sourceMessage.Description,
+			})
+			continue
+		}
+		for _, form := range forms {
+			sourceText := getPluralForm(sourceMessage, form)
+			if sourceText == "" {
+				sourceText = sourceMessage.Other
+			}
+			doc.Units = append(doc.Units, XLIFFUnit{
+				ID:     xliffPluralUnitID(id, form),
+				Source: sourceText,
+				Target: getPluralForm(targetMessage, form),
+				Note:   sourceMessage.Description,
+			})
+		}
+	}
+	return doc.Write(w)
+}
+
+// ReadXLIFF reads an XLIFF 1.2 or 2.0 document.
+func ReadXLIFF(r io.Reader) (*XLIFFDocument, error) {
+	var root xliffXML
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("xliff: %w", err)
+	}
+
+	doc := &XLIFFDocument{Version: XLIFFVersion(root.Version)}
+	switch doc.Version {
+	case XLIFF12:
+		for _, file := range root.Files {
+			doc.SourceLanguage = language.Make(file.SourceLanguage)
+			doc.TargetLanguage = language.Make(file.TargetLanguage)
+			if file.Body == nil {
+				continue
+			}
+			for _, unit := range file.Body.units() {
+				doc.Units = append(doc.Units, XLIFFUnit{
+					ID:     unit.ID,
+					Source: unit.Source,
+					Target: unit.Target,
+					Note:   unit.Note,
+				})
+			}
+		}
+	case XLIFF20:
+		doc.SourceLanguage = language.Make(root.SrcLang)
+		doc.TargetLanguage = language.Make(root.TrgLang)
+		for _, file := range root.Files {
+			for _, unit := range xliff20Units(file.Units, file.Groups) {
+				xliffUnit := XLIFFUnit{ID: unit.ID}
+				if unit.Notes != nil && len(unit.Notes.Notes) > 0 {
+					xliffUnit.Note = unit.Notes.Notes[0]
+				}
+				for _, segment := range unit.Segments {
+					xliffUnit.Source += segment.Source
+					xliffUnit.Target += segment.Target
+				}
+				doc.Units = append(doc.Units, xliffUnit)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("xliff: unsupported version %q", root.Version)
+	}
+	return doc, nil
+}
+
+// Write writes the document as XLIFF.
+func (d *XLIFFDocument) Write(w io.Writer) error {
+	root := xliffXML{Version: string(d.Version)}
+	switch d.Version {
+	case XLIFF12:
+		root.XMLName = xml.Name{Space: xliff12Namespace, Local: "xliff"}
+		body := &xliff12Body{}
+		for _, unit := range d.Units {
+			body.Units = append(body.Units, xliff12Unit{
+				ID:     unit.ID,
+				Source: unit.Source,
+				Target: unit.Target,
+				Note:   unit.Note,
+			})
+		}
+		root.Files = []xliffFileXML{{
+			Original:       xliffFileID,
+			SourceLanguage: d.SourceLanguage.String(),
+			TargetLanguage: d.TargetLanguage.String(),
+			Datatype:       "plaintext",
+			Body:           body,
+		}}
+	case XLIFF20:
+		root.XMLName = xml.Name{Space: xliff20Namespace, Local: "xliff"}
+		root.SrcLang = d.SourceLanguage.String()
+		root.TrgLang = d.TargetLanguage.String()
+		file := xliffFileXML{ID: xliffFileID}
+		for _, unit := range d.Units {
+			xmlUnit := xliff20Unit{ID: unit.ID}
+			if unit.Note != "" {
+				xmlUnit.Notes = &xliff20Notes{Notes: []string{unit.Note}}
+			}
+			state := "initial"
+			if unit.Target != "" {
+				state = "translated"
+			}
+			xmlUnit.Segments = []xliff20Segment{{State: state, Source: unit.Source, Target: unit.Target}}
+			file.Units = append(file.Units, xmlUnit)
+		}
+		root.Files = []xliffFileXML{file}
+	default:
+		return fmt.Errorf("xliff: unsupported version %q", d.Version)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Messages returns the translated units of the document as messages.
+//
+// Plural units are merged back into a single message, and units without a target are skipped.
+func (d *XLIFFDocument) Messages() []*i18n.Message {
+	var messages []*i18n.Message
+	byID := make(map[string]*i18n.Message)
+	for _, unit := range d.Units {
+		if unit.Target == "" {
+			continue
+		}
+		id, form, isPlural := parseXLIFFPluralUnitID(unit.ID)
+		message, ok := byID[id]
+		if !ok {
+			message = &i18n.Message{ID: id, Description: unit.Note}
+			byID[id] = message
+			messages = append(messages, message)
+		}
+		if isPlural {
+			setPluralForm(message, form, unit.Target)
+		} else {
+			message.Other = unit.Target
+		}
+	}
+	return messages
+}
+
+// ImportXLIFF reads an XLIFF document and writes its translations into the message file at path.
+//
+// The file keeps its key order, and keys that are not part of the document are left untouched.
+// See UpdateMessageFile for the supported formats.
+func ImportXLIFF(r io.Reader, path string) error {
+	doc, err := ReadXLIFF(r)
+	if err != nil {
+		return err
+	}
+	return UpdateMessageFile(path, doc.Messages()...)
+}
+
+func xliffPluralUnitID(id string, form plural.Form) string {
+	return id + "[" + pluralFormNames[form] + "]"
+}
+
+func parseXLIFFPluralUnitID(unitID string) (string, plural.Form, bool) {
+	if !strings.HasSuffix(unitID, "]") {
+		return unitID, plural.Other, false
+	}
+	i := strings.LastIndex(unitID, "[")
+	if i < 0 {
+		return unitID, plural.Other, false
+	}
+	name := unitID[i+1 : len(unitID)-1]
+	for form, formName := range pluralFormNames {
+		if formName == name {
+			return unitID[:i], form, true
+		}
+	}
+	return unitID, plural.Other, false
+}
+
+type xliffXML struct {
+	XMLName xml.Name
+	Version string         `xml:"version,attr"`
+	SrcLang string         `xml:"srcLang,attr,omitempty"`
+	TrgLang string         `xml:"trgLang,attr,omitempty"`
+	Files   []xliffFileXML `xml:"file"`
+}
+
+type xliffFileXML struct {
+	ID             string         `xml:"id,attr,omitempty"`
+	Original       string         `xml:"original,attr,omitempty"`
+	SourceLanguage string         `xml:"source-language,attr,omitempty"`
+	TargetLanguage string         `xml:"target-language,attr,omitempty"`
+	Datatype       string         `xml:"datatype,attr,omitempty"`
+	Body           *xliff12Body   `xml:"body,omitempty"`
+	Groups         []xliff20Group `xml:"group,omitempty"`
+	Units          []xliff20Unit  `xml:"unit,omitempty"`
+}
+
+type xliff12Body struct {
+	Groups []xliff12Body `xml:"group,omitempty"`
+	Units  []xliff12Unit `xml:"trans-unit"`
+}
+
+// units returns the units of the body and of its groups, nested groups included.
+func (b *xliff12Body) units() []xliff12Unit {
+	units := slices.Clone(b.Units)
+	for _, group := range b.Groups {
+		units = append(units, group.units()...)
+	}
+	return units
+}
+
+type xliff12Unit struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source"`
+	Target string `xml:"target"`
+	Note   string `xml:"note,omitempty"`
+}
+
+type xliff20Group struct {
+	Groups []xliff20Group `xml:"group,omitempty"`
+	Units  []xliff20Unit  `xml:"unit,omitempty"`
+}
+
+// xliff20Units returns the units and the units of the groups, nested groups included.
+func xliff20Units(units []xliff20Unit, groups []xliff20Group) []xliff20Unit {
+	units = slices.Clone(units)
+	for _, group := range groups {
+		units = append(units, xliff20Units(group.Units, group.Groups)...)
+	}
+	return units
+}
+
+type xliff20Unit struct {
+	ID       string           `xml:"id,attr"`
+	Notes    *xliff20Notes    `xml:"notes,omitempty"`
+	Segments []xliff20Segment `xml:"segment,omitempty"`
+}
+
+type xliff20Notes struct {
+	Notes []string `xml:"note"`
+}
+
+type xliff20Segment struct {
+	State  string `xml:"state,attr,omitempty"`
+	Source string `xml:"source"`
+	Target string `xml:"target"`
+}
@@ -0,0 +1,232 @@
+package i18n_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/afkdevs/go-i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestExportXLIFF(t *testing.T) {
+	initI18n(t)
+
+	expectedUnits := []i18n.XLIFFUnit{
+		{ID: "hello_english", Source: "Hello, This message is only available in English."},
+		{ID: "items[other]", Source: "{{.Count}} items", Target: "{{.Count}} barang"},
+		{ID: "logout", Source: "Log out"},
+		{ID: "welcome", Source: "Welcome", Target: "Selamat datang", Note: "Title of the home page"},
+	}
+
+	for _, version := range []i18n.XLIFFVersion{i18n.XLIFF12, i18n.XLIFF20} {
+		t.Run(string(version), func(t *testing.T) {
+			var buf bytes.Buffer
+			err := i18n.ExportXLIFF(&buf, language.English, language.Indonesian, version)
+			require.NoError(t, err)
+			assert.Contains(t, buf.String(), `version="`+string(version)+`"`)
+
+			doc, err := i18n.ReadXLIFF(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, version, doc.Version)
+			assert.Equal(t, language.English, doc.SourceLanguage)
+			assert.Equal(t, language.Indonesian, doc.TargetLanguage)
+			assert.Subset(t, doc.Units, expectedUnits)
+		})
+	}
+
+	t.Run("plural forms of the target language", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := i18n.ExportXLIFF(&buf, language.English, language.Russian, i18n.XLIFF20)
+		require.NoError(t, err)
+
+		doc, err := i18n.ReadXLIFF(&buf)
+		require.NoError(t, err)
+		var units []i18n.XLIFFUnit
+		for _, unit := range doc.Units {
+			if strings.HasPrefix(unit.ID, "items[") {
+				units = append(units, unit)
+			}
+		}
+		assert.Equal(t, []i18n.XLIFFUnit{
+			{ID: "items[one]", Source: "{{.Count}} item"},
+			{ID: "items[few]", Source: "{{.Count}} items"},
+			{ID: "items[many]", Source: "{{.Count}} items"},
+			{ID: "items[other]", Source: "{{.Count}} items"},
+		}, units)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		err := i18n.ExportXLIFF(&bytes.Buffer{}, language.English, language.Indonesian, "3.0")
+		assert.Error(t, err)
+	})
+}
+
+func TestReadXLIFF(t *testing.T) {
+	t.Run("xliff 1.2 with groups", func(t *testing.T) {
+		doc, err := i18n.ReadXLIFF(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file source-language="en" target-language="id" datatype="plaintext" original="messages">
+    <body>
+      <trans-unit id="logout"><source>Log out</source><target>Keluar</target></trans-unit>
+      <group id="items">
+        <trans-unit id="items[other]"><source>{{.Count}} items</source><target>{{.Count}} barang</target></trans-unit>
+      </group>
+    </body>
+  </file>
+</xliff>`))
+		require.NoError(t, err)
+		assert.Equal(t, []i18n.XLIFFUnit{
+			{ID: "logout", Source: "Log out", Target: "Keluar"},
+			{ID: "items[other]", Source: "{{.Count}} items", Target: "{{.Count}} barang"},
+		}, doc.Units)
+		assert.Len(t, doc.Messages(), 2)
+		assert.Equal(t, "{{.Count}} barang", doc.Messages()[1].Other)
+	})
+	for _, file := range []string{"testdata/groups.1.2.xlf", "testdata/groups.2.0.xlf"} {
+		t.Run("nested groups "+file, func(t *testing.T) {
+			f, err := os.Open(file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			doc, err := i18n.ReadXLIFF(f)
+			require.NoError(t, err)
+			assert.Equal(t, []i18n.XLIFFUnit{
+				{ID: "welcome", Source: "Welcome", Target: "Selamat datang"},
+				{ID: "auth.logout", Source: "Log out", Target: "Keluar"},
+				{ID: "auth.login.title", Source: "Log in", Target: "Masuk"},
+			}, doc.Units)
+		})
+	}
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := i18n.ReadXLIFF(strings.NewReader(`<xliff version="3.0"></xliff>`))
+		assert.Error(t, err)
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := i18n.ReadXLIFF(strings.NewReader(`<xliff`))
+		assert.Error(t, err)
+	})
+}
+
+func TestImportXLIFF(t *testing.T) {
+	const document = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="id">
+  <file id="messages">
+    <unit id="auth.login"><segment><source>Log in</source><target>Masuk</target></segment></unit>
+    <unit id="welcome"><segment><source>Welcome</source><target>Selamat datang</target></segment></unit>
+    <unit id="items[one]"><segment><source>{{.Count}} item</source><target>Satu barang</target></segment></unit>
+    <unit id="items[other]"><segment><source>{{.Count}} items</source><target>{{.Count}} barang</target></segment></unit>
+    <unit id="logout"><segment><source>Log out</source><target></target></segment></unit>
+  </file>
+</xliff>`
+
+	testCases := []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+	}{
+		{
+			name: "yaml",
+			file: "id.yaml",
+			content: `# Indonesian messages
+welcome: Halo
+auth:
+  logout: Keluar
+  login: Login
+untouched: Tetap
+`,
+			expected: `# Indonesian messages
+welcome: Selamat datang
+auth:
+  logout: Keluar
+  login: Masuk
+untouched: Tetap
+items:
+  one: Satu barang
+  other: '{{.Count}} barang'
+`,
+		},
+		{
+			name: "json",
+			file: "id.json",
+			content: `{
+  "welcome": "Halo",
+  "auth": {"logout": "Keluar", "login": "Login"},
+  "untouched": "Tetap"
+}`,
+			expected: `{
+  "welcome": "Selamat datang",
+  "auth": {
+    "logout": "Keluar",
+    "login": "Masuk"
+  },
+  "untouched": "Tetap",
+  "items": {
+    "one": "Satu barang",
+    "other": "{{.Count}} barang"
+  }
+}
+`,
+		},
+		{
+			name: "toml",
+			file: "id.toml",
+			content: `# Indonesian messages
+welcome = "Halo"
+untouched = "Tetap"
+
+[auth]
+logout = "Keluar"
+login = "Login"
+`,
+			expected: `# Indonesian messages
+welcome = "Selamat datang"
+untouched = "Tetap"
+
+[auth]
+logout = "Keluar"
+login = "Masuk"
+
+[items]
+one = "Satu barang"
+other = "{{.Count}} barang"
+`,
+		},
+		{
+			name: "new file",
+			file: "new.yaml",
+			expected: `auth.login: Masuk
+welcome: Selamat datang
+items:
+  one: Satu barang
+  other: '{{.Count}} barang'
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tc.file)
+			if tc.content != "" {
+				require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o644))
+			}
+
+			err := i18n.ImportXLIFF(strings.NewReader(document), path)
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+		})
+	}
+
+	t.Run("unsupported format", func(t *testing.T) {
+		err := i18n.ImportXLIFF(strings.NewReader(document), filepath.Join(t.TempDir(), "id.ini"))
+		assert.Error(t, err)
+	})
+}